@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"runtime"
 	"strconv"
 	"testing"
 
@@ -250,6 +251,57 @@ func BenchmarkSeries_Append(b *testing.B) {
 	}
 }
 
+func BenchmarkSeries_AppendOneByOne(b *testing.B) {
+	rand.Seed(100)
+	values := series.Floats(generateFloats(100000))
+	b.Run("Append", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := series.New([]float64{}, series.Float, "")
+			for j := 0; j < values.Len(); j++ {
+				s.Append(values.Elem(j))
+			}
+		}
+	})
+	b.Run("AppendElement", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := series.New([]float64{}, series.Float, "")
+			for j := 0; j < values.Len(); j++ {
+				s.AppendElement(values.Elem(j))
+			}
+		}
+	})
+	b.Run("CacheAbleAppendElement", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := series.New([]float64{}, series.Float, "").CacheAble()
+			for j := 0; j < values.Len(); j++ {
+				s.AppendElement(values.Elem(j))
+			}
+		}
+	})
+}
+
+func BenchmarkSeries_Builder(b *testing.B) {
+	rand.Seed(100)
+	values := generateFloats(100000)
+	b.Run("Append", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := series.New([]float64{}, series.Float, "")
+			for _, v := range values {
+				s.Append(v)
+			}
+		}
+	})
+	b.Run("Builder", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			builder := series.NewBuilder(series.Float, len(values))
+			for _, v := range values {
+				builder.Add(v)
+			}
+			builder.Build()
+		}
+	})
+}
+
 func BenchmarkSeries_Set(b *testing.B) {
 	rand.Seed(100)
 	table := []struct {
@@ -432,4 +484,109 @@ func compareFloats(lvalue, rvalue float64, digits int) bool {
 	lv := int(lvalue * d)
 	rv := int(rvalue * d)
 	return lv == rv
-}
\ No newline at end of file
+}
+func BenchmarkSeries_MapParallel(b *testing.B) {
+	rand.Seed(100)
+	s := series.Floats(generateFloats(1000000))
+	f := func(ele series.Element, index int) series.Element {
+		result := ele.Copy()
+		x := ele.Float()
+		for k := 0; k < 20; k++ {
+			x = math.Sqrt(x + 1)
+		}
+		result.SetFloat(x)
+		return result
+	}
+	b.Run("Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s.Map(f)
+		}
+	})
+	for _, workers := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("MapParallel(%d)", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				s.MapParallel(f, workers)
+			}
+		})
+	}
+}
+
+func BenchmarkSeries_StdDev(b *testing.B) {
+	rand.Seed(100)
+	table := []struct {
+		name   string
+		series series.Series
+	}{
+		{
+			"[]float64(1000000)_Float",
+			series.Floats(generateFloats(1000000)),
+		},
+		{
+			"[]int(1000000)_Int",
+			series.Ints(generateIntsN(1000000, 1000)),
+		},
+	}
+	for _, test := range table {
+		b.Run(test.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				test.series.StdDev()
+			}
+		})
+	}
+}
+
+func BenchmarkSeries_CompareIn(b *testing.B) {
+	rand.Seed(100)
+	s := series.Ints(generateIntsN(100000, 100000))
+	comparando := series.Ints(generateIntsN(10000, 100000))
+	b.Run("NestedLoop", func(b *testing.B) {
+		in := func(el series.Element) bool {
+			for j := 0; j < comparando.Len(); j++ {
+				if el.Eq(comparando.Elem(j)) {
+					return true
+				}
+			}
+			return false
+		}
+		for i := 0; i < b.N; i++ {
+			s.Compare(series.CompFunc, in)
+		}
+	})
+	b.Run("In", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s.Compare(series.In, comparando)
+		}
+	})
+}
+
+func BenchmarkSeries_RollingMedian(b *testing.B) {
+	rand.Seed(100)
+	s := series.Floats(generateFloats(1000000))
+	b.Run("Median", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s.Rolling(500, 1).Median()
+		}
+	})
+}
+
+func BenchmarkSeries_NewCategorical(b *testing.B) {
+	rand.Seed(100)
+	s := series.Ints(generateIntsN(1000000, 100))
+	for _, t := range []series.Type{series.String, series.Categorical} {
+		b.Run(string(t), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				series.New(s, t, "")
+			}
+			// B/op counts the temporary elements too, the memory kept by the series is reported apart
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			kept := series.New(s, t, "")
+			runtime.GC()
+			runtime.ReadMemStats(&after)
+			b.ReportMetric(float64(int64(after.HeapAlloc)-int64(before.HeapAlloc)), "retained-B")
+			runtime.KeepAlive(kept)
+		})
+	}
+}
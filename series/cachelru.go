@@ -0,0 +1,121 @@
+package series
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// lruSeriesCache is a Cache holding at most maxEntries entries, it is safe for concurrent use.
+// When a new key is set on a full cache, the least recently used entry is evicted.
+// Both Get and Set mark an entry as the most recently used.
+type lruSeriesCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	c          map[string]*list.Element
+	setCount   int
+	getCount   int
+	hitCount   int
+	evictCount int
+}
+
+type lruEntry struct {
+	key   string
+	value interface{}
+}
+
+func newLRUSeriesCache(maxEntries int) Cache {
+	if maxEntries < 1 {
+		panic(fmt.Sprintf("maxEntries must be greater than 0, got %d", maxEntries))
+	}
+	ch := &lruSeriesCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		c:          map[string]*list.Element{},
+	}
+	return ch
+}
+
+func (lc *lruSeriesCache) Set(key string, value interface{}) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.setCount++
+	if e, ok := lc.c[key]; ok {
+		lc.ll.MoveToFront(e)
+		e.Value.(*lruEntry).value = value
+		return
+	}
+	lc.c[key] = lc.ll.PushFront(&lruEntry{key, value})
+	if lc.ll.Len() > lc.maxEntries {
+		oldest := lc.ll.Back()
+		lc.ll.Remove(oldest)
+		delete(lc.c, oldest.Value.(*lruEntry).key)
+		lc.evictCount++
+	}
+}
+
+func (lc *lruSeriesCache) Size() int {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	return lc.ll.Len()
+}
+
+func (lc *lruSeriesCache) Get(key string) (interface{}, bool) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.getCount++
+	e, ok := lc.c[key]
+	if !ok {
+		return nil, false
+	}
+	lc.hitCount++
+	lc.ll.MoveToFront(e)
+	return e.Value.(*lruEntry).value, true
+}
+
+func (lc *lruSeriesCache) Clear() {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.ll.Init()
+	lc.c = map[string]*list.Element{}
+	lc.setCount = 0
+	lc.getCount = 0
+	lc.hitCount = 0
+	lc.evictCount = 0
+}
+
+func (lc *lruSeriesCache) Delete(key string) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if e, ok := lc.c[key]; ok {
+		lc.ll.Remove(e)
+		delete(lc.c, key)
+	}
+}
+
+func (lc *lruSeriesCache) Copy() Cache {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	nc := &lruSeriesCache{
+		maxEntries: lc.maxEntries,
+		ll:         list.New(),
+		c:          map[string]*list.Element{},
+		setCount:   lc.setCount,
+		getCount:   lc.getCount,
+		hitCount:   lc.hitCount,
+		evictCount: lc.evictCount,
+	}
+	for e := lc.ll.Back(); e != nil; e = e.Prev() {
+		entry := e.Value.(*lruEntry)
+		nc.c[entry.key] = nc.ll.PushFront(&lruEntry{entry.key, entry.value})
+	}
+	return nc
+}
+
+func (lc *lruSeriesCache) State() string {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	return fmt.Sprintf("Cache info: size: %d, maxEntries: %d, setCount: %d, getCount: %d, hitCount: %d, evictCount: %d\n",
+		lc.ll.Len(), lc.maxEntries, lc.setCount, lc.getCount, lc.hitCount, lc.evictCount)
+}
@@ -7,10 +7,12 @@ import (
 type cacheAbleRollingSeries struct {
 	RollingSeries
 	c Cache
+	// keyPrefix separates the cache entries of the centered rolling series
+	keyPrefix string
 }
 
 func (rc *cacheAbleRollingSeries) cacheOrExecuteRolling(cacheKey string, f func() Series) Series {
-	if ret, found := rc.c.Get(cacheKey); found {
+	if ret, found := rc.c.Get(rc.keyPrefix + cacheKey); found {
 		return ret.(Series)
 	}
 	res := f()
@@ -19,10 +21,19 @@ func (rc *cacheAbleRollingSeries) cacheOrExecuteRolling(cacheKey string, f func(
 	}
 	res.SetName(cacheKey)
 	ret := res.Immutable()
-	rc.c.Set(cacheKey, ret)
+	rc.c.Set(rc.keyPrefix+cacheKey, ret)
 	return ret
 }
 
+// Center returns the centered rolling series, it shares the cache with the rolling series.
+func (rc cacheAbleRollingSeries) Center() RollingSeries {
+	return cacheAbleRollingSeries{
+		RollingSeries: rc.RollingSeries.Center(),
+		c:             rc.c,
+		keyPrefix:     "Center",
+	}
+}
+
 func (rc cacheAbleRollingSeries) Max() Series {
 	cacheKey := "RMax"
 	ret := rc.cacheOrExecuteRolling(cacheKey, func() Series {
@@ -38,6 +49,20 @@ func (rc cacheAbleRollingSeries) Min() Series {
 	return ret
 
 }
+func (rc cacheAbleRollingSeries) ArgMax() Series {
+	cacheKey := "RArgMax"
+	ret := rc.cacheOrExecuteRolling(cacheKey, func() Series {
+		return rc.RollingSeries.ArgMax()
+	})
+	return ret
+}
+func (rc cacheAbleRollingSeries) ArgMin() Series {
+	cacheKey := "RArgMin"
+	ret := rc.cacheOrExecuteRolling(cacheKey, func() Series {
+		return rc.RollingSeries.ArgMin()
+	})
+	return ret
+}
 func (rc cacheAbleRollingSeries) Mean() Series {
 	cacheKey := "RMean"
 	ret := rc.cacheOrExecuteRolling(cacheKey, func() Series {
@@ -73,3 +98,38 @@ func (rc cacheAbleRollingSeries) StdDev() Series {
 	})
 	return ret
 }
+func (rc cacheAbleRollingSeries) Zscore() Series {
+	cacheKey := "RZscore"
+	ret := rc.cacheOrExecuteRolling(cacheKey, func() Series {
+		return rc.RollingSeries.Zscore()
+	})
+	return ret
+}
+func (rc cacheAbleRollingSeries) Sum() Series {
+	cacheKey := "RSum"
+	ret := rc.cacheOrExecuteRolling(cacheKey, func() Series {
+		return rc.RollingSeries.Sum()
+	})
+	return ret
+}
+func (rc cacheAbleRollingSeries) Count() Series {
+	cacheKey := "RCount"
+	ret := rc.cacheOrExecuteRolling(cacheKey, func() Series {
+		return rc.RollingSeries.Count()
+	})
+	return ret
+}
+func (rc cacheAbleRollingSeries) Var() Series {
+	cacheKey := "RVar"
+	ret := rc.cacheOrExecuteRolling(cacheKey, func() Series {
+		return rc.RollingSeries.Var()
+	})
+	return ret
+}
+func (rc cacheAbleRollingSeries) Skew() Series {
+	cacheKey := "RSkew"
+	ret := rc.cacheOrExecuteRolling(cacheKey, func() Series {
+		return rc.RollingSeries.Skew()
+	})
+	return ret
+}
@@ -2,6 +2,8 @@ package series
 
 import (
 	"fmt"
+	"sync"
+	"sync/atomic"
 )
 
 var _ Series = (*cacheAbleSeries)(nil)
@@ -9,6 +11,8 @@ var _ Series = (*cacheAbleSeries)(nil)
 type cacheAbleSeries struct {
 	Series
 	c Cache
+	// maxEntries bounds the caches with a LRU eviction policy, 0 means unbounded.
+	maxEntries int
 }
 
 func newCacheAbleSeries(s Series) Series {
@@ -19,10 +23,27 @@ func newCacheAbleSeries(s Series) Series {
 	return ret
 }
 
+func newCacheAbleSeriesWithCapacity(s Series, maxEntries int) Series {
+	ret := &cacheAbleSeries{
+		Series:     s.Copy().Immutable(),
+		c:          newLRUSeriesCache(maxEntries),
+		maxEntries: maxEntries,
+	}
+	return ret
+}
+
+// newCache returns an empty cache honoring the capacity of the series.
+func (cs cacheAbleSeries) newCache() Cache {
+	if cs.maxEntries > 0 {
+		return newLRUSeriesCache(cs.maxEntries)
+	}
+	return newSeriesCache()
+}
+
 func (cs cacheAbleSeries) Rolling(window int, minPeriods int) RollingSeries {
 	cr := cacheAbleRollingSeries{
 		RollingSeries: newRollingSeries(window, minPeriods, cs.Series),
-		c:             newSeriesCache(),
+		c:             cs.newCache(),
 	}
 	return cr
 }
@@ -36,6 +57,24 @@ func (cs cacheAbleSeries) HasNaN() bool {
 	return ret.(bool)
 }
 
+func (cs cacheAbleSeries) Count() int {
+	cacheKey := "Count"
+	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
+		ret := cs.Series.Count()
+		return ret, nil
+	})
+	return ret.(int)
+}
+
+func (cs cacheAbleSeries) NUnique() int {
+	cacheKey := "NUnique"
+	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
+		ret := cs.Series.NUnique()
+		return ret, nil
+	})
+	return ret.(int)
+}
+
 func (cs *cacheAbleSeries) cacheOrExecute(cacheKey string, f func() (interface{}, error)) (interface{}, error) {
 	if ret, found := cs.c.Get(cacheKey); found {
 		return ret, nil
@@ -147,6 +186,85 @@ func (cs cacheAbleSeries) StdDev() float64 {
 	return ret.(float64)
 }
 
+func (cs cacheAbleSeries) StdDevPopulation() float64 {
+	cacheKey := "StdDevPopulation"
+	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
+		ret := cs.Series.StdDevPopulation()
+		return ret, nil
+	})
+	return ret.(float64)
+}
+
+func (cs cacheAbleSeries) Skew() float64 {
+	cacheKey := "Skew"
+	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
+		ret := cs.Series.Skew()
+		return ret, nil
+	})
+	return ret.(float64)
+}
+
+func (cs cacheAbleSeries) Fingerprint() uint64 {
+	cacheKey := "Fingerprint"
+	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
+		ret := cs.Series.Fingerprint()
+		return ret, nil
+	})
+	return ret.(uint64)
+}
+
+func (cs cacheAbleSeries) Cov(other Series) float64 {
+	return cs.cacheOrExecuteWith("Cov", other, func() float64 {
+		return cs.Series.Cov(other)
+	})
+}
+
+func (cs cacheAbleSeries) Corr(other Series) float64 {
+	return cs.cacheOrExecuteWith("Corr", other, func() float64 {
+		return cs.Series.Corr(other)
+	})
+}
+
+// otherResult is the cached result of a computation with another series, a copy of the other
+// series is kept to tell apart the series whose fingerprints collide.
+type otherResult struct {
+	other Series
+	value float64
+}
+
+// cacheOrExecuteWith caches the result of f computed with other under the fingerprint of other,
+// a cached result is only returned when its series is Equal to other.
+func (cs *cacheAbleSeries) cacheOrExecuteWith(name string, other Series, f func() float64) float64 {
+	cacheKey := fmt.Sprintf("%s(%x)", name, other.Fingerprint())
+	if ret, found := cs.c.Get(cacheKey); found {
+		if cached := ret.(otherResult); cached.other.Equal(other) {
+			return cached.value
+		}
+		return f()
+	}
+	ret := f()
+	cs.c.Set(cacheKey, otherResult{other.Copy(), ret})
+	return ret
+}
+
+func (cs cacheAbleSeries) Kurtosis() float64 {
+	cacheKey := "Kurtosis"
+	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
+		ret := cs.Series.Kurtosis()
+		return ret, nil
+	})
+	return ret.(float64)
+}
+
+func (cs cacheAbleSeries) SEM() float64 {
+	cacheKey := "SEM"
+	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
+		ret := cs.Series.SEM()
+		return ret, nil
+	})
+	return ret.(float64)
+}
+
 func (cs cacheAbleSeries) Mean() float64 {
 	cacheKey := "Mean"
 	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
@@ -258,11 +376,30 @@ func (cs cacheAbleSeries) Sum() float64 {
 	return ret.(float64)
 }
 
+func (cs cacheAbleSeries) SumSkipNaN() float64 {
+	cacheKey := "SumSkipNaN"
+	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
+		ret := cs.Series.SumSkipNaN()
+		return ret, nil
+	})
+	return ret.(float64)
+}
+
+func (cs cacheAbleSeries) MeanSkipNaN() float64 {
+	cacheKey := "MeanSkipNaN"
+	ret, _ := cs.cacheOrExecute(cacheKey, func() (interface{}, error) {
+		ret := cs.Series.MeanSkipNaN()
+		return ret, nil
+	})
+	return ret.(float64)
+}
+
 func (cs cacheAbleSeries) Copy() Series {
 	s := cs.Series.Copy()
 	ret := &cacheAbleSeries{
-		Series: s,
-		c:      cs.c.Copy(),
+		Series:     s,
+		c:          cs.c.Copy(),
+		maxEntries: cs.maxEntries,
 	}
 	return ret
 }
@@ -271,10 +408,126 @@ func (cs *cacheAbleSeries) CacheAble() Series {
 	return cs
 }
 
+func (cs *cacheAbleSeries) CacheAbleWithCapacity(maxEntries int) Series {
+	return newCacheAbleSeriesWithCapacity(cs.Series, maxEntries)
+}
+
 func (cs *cacheAbleSeries) Str() string {
 	return cs.Series.Str() + "\n" + cs.c.State()
 }
 
+// ResetCache clears all the cached results of the series.
+func (cs *cacheAbleSeries) ResetCache() {
+	cs.c.Clear()
+}
+
+// inner returns the mutable series wrapped by the series.
+func (cs *cacheAbleSeries) inner() Series {
+	if s, ok := cs.Series.(*immutableSeries); ok {
+		return s.Series
+	}
+	return cs.Series
+}
+
+// mutate applies f to a copy of the underlying series, replaces the underlying series
+// by the modified copy and resets the cache, so the cached results are never stale.
+func (cs *cacheAbleSeries) mutate(f func(s Series)) {
+	s := cs.Series.Copy()
+	f(s)
+	cs.Series = s.Immutable()
+	cs.ResetCache()
+}
+
+func (cs *cacheAbleSeries) Append(values interface{}) {
+	cs.mutate(func(s Series) {
+		s.Append(values)
+	})
+}
+
+// AppendElement appends e in place, the views of the series keep their length so it is not copied.
+func (cs *cacheAbleSeries) AppendElement(e Element) {
+	cs.inner().AppendElement(e)
+	cs.ResetCache()
+}
+
+func (cs *cacheAbleSeries) Set(indexes Indexes, newvalues Series) Series {
+	cs.mutate(func(s Series) {
+		s.Set(indexes, newvalues)
+	})
+	return cs
+}
+
+// SetName renames the series in place and resets the cache, as the cached results may be named after it.
+func (cs *cacheAbleSeries) SetName(name string) {
+	cs.inner().SetName(name)
+	cs.ResetCache()
+}
+
+// SetErr sets the error of the series in place and resets the cache.
+func (cs *cacheAbleSeries) SetErr(err error) {
+	cs.inner().SetErr(err)
+	cs.ResetCache()
+}
+
+// Immutable returns an immutable view of the series which does not see the elements appended later.
+func (cs *cacheAbleSeries) Immutable() Series {
+	return cs.inner().Immutable()
+}
+
+// Self returns a Self whose elements are immutable, so any modification panics
+// as it would not reset the cache.
+func (cs *cacheAbleSeries) Self() Self {
+	return newImmutableSeries(cs.inner()).Self()
+}
+
+func (cs *cacheAbleSeries) FillNaN(value ElementValue) {
+	cs.mutate(func(s Series) {
+		s.FillNaN(value)
+	})
+}
+
+func (cs *cacheAbleSeries) FillNaNForward() {
+	cs.mutate(func(s Series) {
+		s.FillNaNForward()
+	})
+}
+
+func (cs *cacheAbleSeries) FillNaNBackward() {
+	cs.mutate(func(s Series) {
+		s.FillNaNBackward()
+	})
+}
+
+func (cs *cacheAbleSeries) FillNaNForwardLimit(limit int) {
+	cs.mutate(func(s Series) {
+		s.FillNaNForwardLimit(limit)
+	})
+}
+
+func (cs *cacheAbleSeries) FillNaNBackwardLimit(limit int) {
+	cs.mutate(func(s Series) {
+		s.FillNaNBackwardLimit(limit)
+	})
+}
+
+func (cs *cacheAbleSeries) Interpolate(extrapolate bool) {
+	cs.mutate(func(s Series) {
+		s.Interpolate(extrapolate)
+	})
+}
+
+func (cs *cacheAbleSeries) Replace(old, new ElementValue) {
+	cs.mutate(func(s Series) {
+		s.Replace(old, new)
+	})
+}
+
+func (cs *cacheAbleSeries) ReplaceMap(m map[interface{}]interface{}) {
+	cs.mutate(func(s Series) {
+		s.ReplaceMap(m)
+	})
+}
+
 
 //Cache define series cache
 type Cache interface {
@@ -287,11 +540,13 @@ type Cache interface {
 	State() string
 }
 
+// seriesCache is the default Cache implementation, it is safe for concurrent use.
 type seriesCache struct {
-	c map[string]interface{}
-	setCount int
-	getCount int
-	hitCount int
+	mu       sync.RWMutex
+	c        map[string]interface{}
+	setCount int64
+	getCount int64
+	hitCount int64
 }
 
 func newSeriesCache() Cache {
@@ -302,24 +557,32 @@ func newSeriesCache() Cache {
 }
 
 func (dc *seriesCache) Set(key string, value interface{}) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
 	dc.setCount++
 	dc.c[key] = value
 }
 
 func (dc *seriesCache) Size() int {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
 	return len(dc.c)
 }
 
 func (dc *seriesCache) Get(key string) (interface{}, bool) {
-	dc.getCount++
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	atomic.AddInt64(&dc.getCount, 1)
 	v, ok := dc.c[key]
 	if ok {
-		dc.hitCount++
+		atomic.AddInt64(&dc.hitCount, 1)
 	}
 	return v, ok
 }
 
 func (dc *seriesCache) Clear() {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
 	dc.c = make(map[string]interface{})
 	dc.setCount = 0
 	dc.getCount = 0
@@ -327,10 +590,14 @@ func (dc *seriesCache) Clear() {
 }
 
 func (dc *seriesCache) Delete(key string) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
 	delete(dc.c, key)
 }
 
 func (dc *seriesCache) Copy() Cache {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
 	nc := &seriesCache{
 		c:        map[string]interface{}{},
 		setCount: dc.setCount,
@@ -344,5 +611,7 @@ func (dc *seriesCache) Copy() Cache {
 }
 
 func (dc *seriesCache) State() string {
-	return fmt.Sprintf("Cache info: size: %d, setCount: %d, getCount: %d, hitCount: %d\n", dc.Size(), dc.setCount, dc.getCount, dc.hitCount)
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return fmt.Sprintf("Cache info: size: %d, setCount: %d, getCount: %d, hitCount: %d\n", len(dc.c), dc.setCount, dc.getCount, dc.hitCount)
 }
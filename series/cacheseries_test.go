@@ -3,6 +3,7 @@ package series
 import (
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -47,3 +48,196 @@ func TestCacheSeries_Add(t *testing.T) {
 	}
 
 }
+
+func TestCacheSeries_Concurrent(t *testing.T) {
+	cs := Floats([]float64{1.5, -3.23, -0.33, -0.38, 1.6, 34.}).CacheAble()
+	expectedMean := cs.Mean()
+	expectedMax := cs.Max()
+	cs.(*cacheAbleSeries).c.Clear()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if mean := cs.Mean(); mean != expectedMean {
+					t.Errorf("Expected mean %v, received %v", expectedMean, mean)
+				}
+				if max := cs.Max(); max != expectedMax {
+					t.Errorf("Expected max %v, received %v", expectedMax, max)
+				}
+				_ = cs.Quantile(float64(i%10) / 10)
+				_ = cs.Str()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCacheSeries_WithCapacity(t *testing.T) {
+	maxEntries := 3
+	cs := Floats([]float64{1.5, -3.23, -0.33, -0.38, 1.6, 34.}).CacheAbleWithCapacity(maxEntries)
+	c := cs.(*cacheAbleSeries).c
+	for i := 0; i < 10; i++ {
+		expected := Floats([]float64{1.5, -3.23, -0.33, -0.38, 1.6, 34.}).Quantile(float64(i) / 10)
+		received := cs.Quantile(float64(i) / 10)
+		if received != expected {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", i, expected, received)
+		}
+		if size := c.Size(); size > maxEntries {
+			t.Errorf("Test:%v\nExpected at most %v entries, received %v", i, maxEntries, size)
+		}
+	}
+
+	// Get marks the entry as recently used, so Mean survives the next insertion
+	_ = cs.Mean()
+	_ = cs.Max()
+	_ = cs.Min()
+	_ = cs.Mean()
+	_ = cs.StdDev()
+	if _, found := c.Get("Mean"); !found {
+		t.Errorf("Expected Mean to be kept in the cache")
+	}
+	if _, found := c.Get("Max"); found {
+		t.Errorf("Expected Max to be evicted from the cache")
+	}
+
+	rs := cs.Rolling(2, 1).(cacheAbleRollingSeries)
+	for _, p := range []float64{0.1, 0.2, 0.3, 0.4, 0.5} {
+		_ = rs.Quantile(p)
+	}
+	if size := rs.c.Size(); size > maxEntries {
+		t.Errorf("Expected at most %v rolling entries, received %v", maxEntries, size)
+	}
+	if size := cs.Copy().(*cacheAbleSeries).c.Size(); size != c.Size() {
+		t.Errorf("Expected copied cache of size %v, received %v", c.Size(), size)
+	}
+
+	defer func() {
+		if err := recover(); err == nil {
+			t.Errorf("Expected panic when maxEntries < 1")
+		}
+	}()
+	Floats([]float64{1}).CacheAbleWithCapacity(0)
+}
+
+func TestCacheSeries_ResetCache(t *testing.T) {
+	cs := Floats([]float64{1, 2, 3}).CacheAble()
+	if sum := cs.Sum(); sum != 6 {
+		t.Errorf("Expected sum 6, received %v", sum)
+	}
+
+	cs.Append([]float64{4})
+	if sum := cs.Sum(); sum != 10 {
+		t.Errorf("Append: Expected sum 10, received %v", sum)
+	}
+
+	cs.Set(0, Floats([]float64{11}))
+	if sum := cs.Sum(); sum != 20 {
+		t.Errorf("Set: Expected sum 20, received %v", sum)
+	}
+
+	cs.Set(1, Floats([]string{NaN}))
+	if !cs.HasNaN() {
+		t.Errorf("Set: Expected NaN element")
+	}
+	cs.FillNaN(2)
+	if cs.HasNaN() {
+		t.Errorf("FillNaN: Expected no NaN element")
+	}
+
+	cs.SetName("renamed")
+	if name := cs.Name(); name != "renamed" {
+		t.Errorf("SetName: Expected name renamed, received %v", name)
+	}
+	_ = cs.Sum()
+
+	c := cs.(*cacheAbleSeries).c
+	if c.Size() == 0 {
+		t.Errorf("Expected cached results")
+	}
+	cs.ResetCache()
+	if size := c.Size(); size != 0 {
+		t.Errorf("ResetCache: Expected empty cache, received size %v", size)
+	}
+
+	defer func() {
+		if err := recover(); err == nil {
+			t.Errorf("Expected the elements of a cacheable series to be immutable")
+		}
+	}()
+	cs.Elem(0).SetFloat(1)
+}
+
+func TestCacheSeries_InPlace(t *testing.T) {
+	cs := Floats([]float64{1, 2}).CacheAble()
+	view := cs.Immutable()
+	if sum := cs.Sum(); sum != 3 {
+		t.Errorf("Expected sum 3, received %v", sum)
+	}
+
+	cs.AppendElement(Floats([]float64{4}).Elem(0))
+	if sum := cs.Sum(); sum != 7 {
+		t.Errorf("AppendElement: Expected sum 7, received %v", sum)
+	}
+	if view.Len() != 2 {
+		t.Errorf("AppendElement: Expected the immutable view to keep length 2, received %v", view.Len())
+	}
+
+	_ = cs.Not()
+	_ = cs.Sum()
+	cs.SetName("renamed")
+	if _, found := cs.(*cacheAbleSeries).c.Get("Sum"); found {
+		t.Errorf("SetName: Expected the cache to be reset")
+	}
+	if name := cs.Name(); name != "renamed" {
+		t.Errorf("SetName: Expected name renamed, received %v", name)
+	}
+	if name := cs.Not().Name(); name != "Not(renamed)" {
+		t.Errorf("SetName: Expected name Not(renamed), received %v", name)
+	}
+
+	cs.SetErr(fmt.Errorf("error"))
+	if cs.Error() == nil {
+		t.Errorf("SetErr: Expected an error")
+	}
+	if size := cs.(*cacheAbleSeries).c.Size(); size != 0 {
+		t.Errorf("SetErr: Expected empty cache, received size %v", size)
+	}
+
+	defer func() {
+		if err := recover(); err == nil {
+			t.Errorf("Expected the elements of Self of a cacheable series to be immutable")
+		}
+	}()
+	cs.Self().Apply(func(ele Element, index int) {
+		ele.SetFloat(0)
+	})
+}
+
+func TestCacheSeries_CacheKeyByFingerprint(t *testing.T) {
+	cs := Floats([]float64{1, 2, 3}).CacheAble()
+	up := Floats([]float64{1, 2, 4})
+	up.SetName("other")
+	down := Floats([]float64{3, 2, 1})
+	down.SetName("other")
+
+	if corr := cs.Corr(up); corr <= 0 {
+		t.Errorf("Expected positive correlation, received %v", corr)
+	}
+	if corr := cs.Corr(down); corr != -1 {
+		t.Errorf("Expected correlation -1 with a series of the same name, received %v", corr)
+	}
+	if cov := cs.Cov(down); cov != -1 {
+		t.Errorf("Expected covariance -1, received %v", cov)
+	}
+
+	// a cached result of a colliding fingerprint is not returned for a different series
+	c := cs.(*cacheAbleSeries)
+	collision := Floats([]float64{3, 2, 1})
+	c.c.Set(fmt.Sprintf("Cov(%x)", collision.Fingerprint()), otherResult{Floats([]float64{9, 9, 9}), 42})
+	if cov := cs.Cov(collision); cov != -1 {
+		t.Errorf("Expected covariance -1 on a fingerprint collision, received %v", cov)
+	}
+}
@@ -0,0 +1,47 @@
+package series
+
+import (
+	"fmt"
+	"math"
+)
+
+// EWM calculates the exponentially weighted moving average of the series and returns a Float Series.
+// The smoothing factor is alpha = 2/(span+1) and the average is computed recursively:
+//
+//	y[0] = x[0]
+//	y[t] = (1-alpha)*y[t-1] + alpha*x[t]
+//
+// NaN elements are skipped in the recursion and yield the last average.
+func (s series) EWM(span float64) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	if span < 1 {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("ewm error: span must >= 1"))
+		return empty
+	}
+	alpha := 2 / (span + 1)
+
+	length := s.Len()
+	elements := make(floatElements, length)
+	avg := math.NaN()
+	for i := 0; i < length; i++ {
+		ele := s.elements.Elem(i)
+		if !ele.IsNA() {
+			if math.IsNaN(avg) {
+				avg = ele.Float()
+			} else {
+				avg = (1-alpha)*avg + alpha*ele.Float()
+			}
+		}
+		elements[i].SetFloat(avg)
+	}
+	ret := &series{
+		name:     fmt.Sprintf("%s_EWM(%v)", s.name, span),
+		elements: elements,
+		t:        Float,
+		err:      nil,
+	}
+	return ret
+}
@@ -0,0 +1,45 @@
+package series
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSeries_EWM(t *testing.T) {
+	tests := []struct {
+		series   Series
+		span     float64
+		expected Series
+	}{
+		{
+			Floats([]string{"1", "2", "3", "4"}),
+			3,
+			Floats([]string{"1", "1.5", "2.25", "3.125"}),
+		},
+		{
+			Ints([]string{NaN, "4", NaN, "8"}),
+			3,
+			Floats([]string{NaN, "4", "4", "6"}),
+		},
+		{
+			Floats([]string{"1", "2", "3"}),
+			1,
+			Floats([]string{"1", "2", "3"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.expected.Records()
+		received := test.series.EWM(test.span).Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+
+	if err := Floats([]float64{1}).EWM(0.5).Error(); err == nil {
+		t.Errorf("Expected error when span < 1")
+	}
+}
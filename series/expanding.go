@@ -0,0 +1,72 @@
+package series
+
+import (
+	"fmt"
+)
+
+// ExpandingSeries defines methods of an expanding series, the window of the i-th element is [0, i].
+type ExpandingSeries interface {
+	// Max return the biggest element in the expanding series
+	Max() Series
+	// Min return the lowest element in the expanding series
+	Min() Series
+	// Mean calculates the average value of the expanding series
+	Mean() Series
+	// Sum calculates the sum of the non-NaN values of the expanding series,
+	// NaN when there are fewer than minPeriods non-NaN values in the window.
+	Sum() Series
+	// StdDev calculates the standard deviation of the expanding series
+	StdDev() Series
+	// Apply applies a function for the expanding series
+	Apply(f func(window Series, windowIndex int) interface{}, t Type) Series
+}
+
+type expandingSeries struct {
+	rs   RollingSeries
+	name string
+}
+
+// newExpandingSeries establish an expanding Series
+func newExpandingSeries(minPeriods int, s Series) ExpandingSeries {
+	if minPeriods < 1 {
+		panic("minPeriods must >= 1")
+	}
+	// an expanding window is a rolling window which is never full
+	window := s.Len()
+	if window < minPeriods {
+		window = minPeriods
+	}
+	return expandingSeries{
+		rs:   newRollingSeries(window, minPeriods, s),
+		name: s.Name(),
+	}
+}
+
+func (s expandingSeries) Max() Series {
+	return s.rename(s.rs.Max(), "EMax")
+}
+
+func (s expandingSeries) Min() Series {
+	return s.rename(s.rs.Min(), "EMin")
+}
+
+func (s expandingSeries) Mean() Series {
+	return s.rename(s.rs.Mean(), "EMean")
+}
+
+func (s expandingSeries) Sum() Series {
+	return s.rename(s.rs.Sum(), "ESum")
+}
+
+func (s expandingSeries) StdDev() Series {
+	return s.rename(s.rs.StdDev(), "EStdDev")
+}
+
+func (s expandingSeries) Apply(f func(window Series, windowIndex int) interface{}, t Type) Series {
+	return s.rename(s.rs.Apply(f, t), "EApply")
+}
+
+func (s expandingSeries) rename(newS Series, op string) Series {
+	newS.SetName(fmt.Sprintf("%s_%s", s.name, op))
+	return newS
+}
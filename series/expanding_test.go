@@ -0,0 +1,76 @@
+package series
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSeries_Expanding(t *testing.T) {
+	tests := []struct {
+		series         Series
+		minPeriod      int
+		maxExpected    Series
+		minExpected    Series
+		meanExpected   Series
+		sumExpected    Series
+		stdDevExpected Series
+	}{
+		{
+			Floats([]string{"1", "3", "-1", "5"}),
+			2,
+			Floats([]string{NaN, "3", "3", "5"}),
+			Floats([]string{NaN, "1", "-1", "-1"}),
+			Floats([]string{NaN, "2", "1", "2"}),
+			Floats([]string{NaN, "4", "3", "8"}),
+			Floats([]string{NaN, "1.414214", "2", "2.581989"}),
+		},
+		{
+			Ints([]string{"23", "13", "101"}),
+			5,
+			Ints([]string{NaN, NaN, NaN}),
+			Ints([]string{NaN, NaN, NaN}),
+			Floats([]string{NaN, NaN, NaN}),
+			Floats([]string{NaN, NaN, NaN}),
+			Floats([]string{NaN, NaN, NaN}),
+		},
+	}
+
+	for testnum, test := range tests {
+		es := test.series.Expanding(test.minPeriod)
+		results := []struct {
+			op       string
+			expected Series
+			received Series
+		}{
+			{"Max", test.maxExpected, es.Max()},
+			{"Min", test.minExpected, es.Min()},
+			{"Mean", test.meanExpected, es.Mean()},
+			{"Sum", test.sumExpected, es.Sum()},
+			{"StdDev", test.stdDevExpected, es.StdDev()},
+		}
+		for _, r := range results {
+			expected := r.expected.Records()
+			received := r.received.Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-%s:%v\nExpected:\n%v\nReceived:\n%v",
+					r.op, testnum, expected, received,
+				)
+			}
+		}
+	}
+}
+
+func TestSeries_ExpandingApply(t *testing.T) {
+	s := Ints([]int{1, 2, 3, 4})
+	expected := Ints([]int{1, 2, 3, 4}).Records()
+	received := s.Expanding(1).Apply(func(window Series, windowIndex int) interface{} {
+		return window.Len()
+	}, Int).Records()
+	if !reflect.DeepEqual(expected, received) {
+		t.Errorf(
+			"Test-Apply\nExpected:\n%v\nReceived:\n%v",
+			expected, received,
+		)
+	}
+}
@@ -31,6 +31,18 @@ func (s immutableSeries) Slice(start, end int) Series {
 	}
 	return ret
 }
+func (s immutableSeries) Head(n int) Series {
+	ret := &immutableSeries{
+		Series: s.Series.Head(n),
+	}
+	return ret
+}
+func (s immutableSeries) Tail(n int) Series {
+	ret := &immutableSeries{
+		Series: s.Series.Tail(n),
+	}
+	return ret
+}
 func (s *immutableSeries) FillNaN(value ElementValue) {
 	panic("The method[FillNaN] is not supported by immutableSeries")
 }
@@ -40,12 +52,71 @@ func (s *immutableSeries) FillNaNForward() {
 func (s *immutableSeries) FillNaNBackward() {
 	panic("The method[FillNaNBackward] is not supported by immutableSeries")
 }
+func (s *immutableSeries) FillNaNForwardLimit(limit int) {
+	panic("The method[FillNaNForwardLimit] is not supported by immutableSeries")
+}
+func (s *immutableSeries) FillNaNBackwardLimit(limit int) {
+	panic("The method[FillNaNBackwardLimit] is not supported by immutableSeries")
+}
+func (s *immutableSeries) Interpolate(extrapolate bool) {
+	panic("The method[Interpolate] is not supported by immutableSeries")
+}
+func (s *immutableSeries) Replace(old, new ElementValue) {
+	panic("The method[Replace] is not supported by immutableSeries")
+}
+func (s *immutableSeries) ReplaceMap(m map[interface{}]interface{}) {
+	panic("The method[ReplaceMap] is not supported by immutableSeries")
+}
 func (s *immutableSeries) Set(indexes Indexes, newvalues Series) Series {
 	panic("The method[Set] is not supported by immutableSeries")
 }
 func (s *immutableSeries) Append(values interface{}) {
 	panic("The method[Append] is not supported by immutableSeries")
 }
+func (s *immutableSeries) AppendElement(e Element) {
+	panic("The method[AppendElement] is not supported by immutableSeries")
+}
+func (s *immutableSeries) SetName(name string) {
+	panic("The method[SetName] is not supported by immutableSeries")
+}
+func (s *immutableSeries) SetErr(err error) {
+	panic("The method[SetErr] is not supported by immutableSeries")
+}
+
+// ForEach passes immutable elements to f, so any modification panics.
+func (s immutableSeries) ForEach(f func(i int, e Element) bool) {
+	for i := 0; i < s.Len(); i++ {
+		if !f(i, s.Elem(i)) {
+			return
+		}
+	}
+}
+
+// Reduce passes immutable elements to f, so any modification panics.
+func (s immutableSeries) Reduce(init interface{}, f func(acc interface{}, ele Element, index int) interface{}) interface{} {
+	acc := init
+	for i := 0; i < s.Len(); i++ {
+		acc = f(acc, s.Elem(i), i)
+	}
+	return acc
+}
+
+// Find passes immutable elements to ff, so any modification panics.
+func (s immutableSeries) Find(ff FilterFunction) int {
+	for i := 0; i < s.Len(); i++ {
+		if ff(s.Elem(i), i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Self returns a Self whose elements are immutable, so any modification panics.
+func (s *immutableSeries) Self() Self {
+	return Self{
+		this: s,
+	}
+}
 
 //immutableElement is an immutable element and the element can not be modified.
 type immutableElement struct {
@@ -70,3 +141,6 @@ func (e *immutableElement) SetInt(val int) {
 func (e *immutableElement) SetString(val string) {
 	panic("The method[SetString] is not supported by immutableElement")
 }
+func (e *immutableElement) SetNA() {
+	panic("The method[SetNA] is not supported by immutableElement")
+}
@@ -2,6 +2,7 @@ package series
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -17,6 +18,12 @@ func TestImmutableSeries_ModifyPanic(t *testing.T) {
 				s.Elem(0).SetString(NaN)
 			},
 		},
+		{
+			Floats([]string{"2", "1", "3", "NaN", "4", "NaN"}),
+			func(s Series) {
+				s.Elem(1).SetNA()
+			},
+		},
 		{
 			Floats([]string{"2", "1", "3", "NaN", "4", "NaN"}),
 			func(s Series) {
@@ -47,9 +54,119 @@ func TestImmutableSeries_ModifyPanic(t *testing.T) {
 				s.Append([]bool{true, false})
 			},
 		},
+		{
+			Bools([]bool{true}),
+			func(s Series) {
+				s.AppendElement(s.Elem(0))
+			},
+		},
+		{
+			Floats([]string{"2", "NaN", "4"}),
+			func(s Series) {
+				s.Interpolate(false)
+			},
+		},
+		{
+			Floats([]string{"2", "NaN", "4"}),
+			func(s Series) {
+				s.FillNaNForwardLimit(1)
+			},
+		},
+		{
+			Ints([]string{"2", "NaN", "4"}),
+			func(s Series) {
+				s.FillNaNBackwardLimit(1)
+			},
+		},
+		{
+			Ints([]string{"2", "NaN", "4"}),
+			func(s Series) {
+				s.Replace(2, 3)
+			},
+		},
+		{
+			Strings([]string{"a", "b"}),
+			func(s Series) {
+				s.ReplaceMap(map[interface{}]interface{}{"a": "c"})
+			},
+		},
+		{
+			Strings([]string{"a", "b"}),
+			func(s Series) {
+				s.SetName("b")
+			},
+		},
+		{
+			Ints([]int{1, 2}),
+			func(s Series) {
+				s.SetErr(fmt.Errorf("error"))
+			},
+		},
+		{
+			Ints([]int{1, 2}),
+			func(s Series) {
+				s.Self().Apply(func(ele Element, index int) {
+					ele.SetInt(0)
+				})
+			},
+		},
+		{
+			Floats([]float64{1, 2}),
+			func(s Series) {
+				s.Set([]int{0}, Floats([]float64{5}))
+			},
+		},
+		{
+			Ints([]int{1, 2}),
+			func(s Series) {
+				s.Self().Map(func(ele Element, index int) Element {
+					return ele
+				})
+			},
+		},
+		{
+			Floats([]float64{1, 2}),
+			func(s Series) {
+				s.Head(1).Elem(0).SetFloat(5)
+			},
+		},
+		{
+			Ints([]int{1, 2}),
+			func(s Series) {
+				s.ForEach(func(i int, e Element) bool {
+					e.SetInt(0)
+					return true
+				})
+			},
+		},
+		{
+			Floats([]float64{1, 2, 3}),
+			func(s Series) {
+				s.Find(func(e Element, index int) bool {
+					e.SetFloat(9)
+					return false
+				})
+			},
+		},
+		{
+			Floats([]float64{1, 2, 3}),
+			func(s Series) {
+				s.Reduce(0.0, func(acc interface{}, e Element, index int) interface{} {
+					e.SetFloat(9)
+					return acc
+				})
+			},
+		},
+		{
+			Floats([]float64{1, 2}),
+			func(s Series) {
+				s.Tail(1).Elem(0).SetFloat(5)
+			},
+		},
 	}
 	for testnum, test := range tests {
 		received := test.series.Immutable()
+		expected := test.series.Records()
 		modifySeries := test.modifySeries
 		name := fmt.Sprintf("Test-%d", testnum)
 		t.Run(name, func(t *testing.T) {
@@ -58,6 +175,12 @@ func TestImmutableSeries_ModifyPanic(t *testing.T) {
 				if err == nil || !strings.Contains(err.(string), "is not supported by") {
 					t.Errorf("Test:%v\nError, must panic: %v", testnum, err)
 				}
+				if !reflect.DeepEqual(expected, received.Records()) || received.Name() != "" || received.Error() != nil {
+					t.Errorf(
+						"Test:%v\nExpected unchanged:\n%v\nReceived:\n%v",
+						testnum, expected, received,
+					)
+				}
 			}()
 			modifySeries(received)
 		})
@@ -2,8 +2,11 @@ package series
 
 import (
 	"fmt"
+	"math"
+	"sort"
 
 	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/stat"
 )
 
 //RollingSeries defines methods of a rolling series
@@ -12,10 +15,20 @@ type RollingSeries interface {
 	Max() Series
 	// Min return the lowest element in the rolling series
 	Min() Series
+	// ArgMax returns the offset within each window (0 is the oldest) of the first biggest non-NaN element
+	// as a Float Series, NaN when there are fewer than minPeriods non-NaN values in the window.
+	ArgMax() Series
+	// ArgMin returns the offset within each window (0 is the oldest) of the first lowest non-NaN element
+	// as a Float Series, NaN when there are fewer than minPeriods non-NaN values in the window.
+	ArgMin() Series
 	// Mean calculates the average value of the rolling series
 	Mean() Series
 	// Mean calculates the weighted average value of the rolling series
 	MeanByWeights(weights []float64) Series
+	// ApplyWeighted applies a weighted float aggregation function for the rolling series and returns
+	// a Float Series. The length of weights must be equal to the window, the windows shorter than the
+	// window are given the trailing weights. Like MeanByWeights, NaN values are passed to f as is.
+	ApplyWeighted(weights []float64, f func(vals, weights []float64) float64) Series
 	// Quantile returns the quantile of the window of the rolling series.
 	Quantile(p float64) Series
 	// Quantiles can be computed in batches.
@@ -30,16 +43,44 @@ type RollingSeries interface {
 	Median() Series
 	// StdDev calculates the standard deviation of the rolling series
 	StdDev() Series
+	// Zscore standardizes each element by its window as (x - Mean) / StdDev and returns a Float Series.
+	// Like Series.ZScore, it is 0 when the standard deviation is 0 or undefined, and NaN when the Mean is NaN.
+	Zscore() Series
+	// Var calculates the variance of the rolling series
+	Var() Series
+	// Skew calculates the skewness of the rolling series
+	Skew() Series
+	// Corr calculates the Pearson correlation between each window of the rolling series
+	// and the window of other at the same position. Pairs where either element is NaN
+	// are skipped, NaN when there are fewer than minPeriods pairs in the window.
+	Corr(other Series) Series
+	// Cov calculates the sample covariance between each window of the rolling series
+	// and the window of other at the same position. Pairs where either element is NaN
+	// are skipped, NaN when there are fewer than minPeriods pairs in the window.
+	Cov(other Series) Series
+	// Sum calculates the sum of the non-NaN values of the rolling series,
+	// NaN when there are fewer than minPeriods non-NaN values in the window.
+	Sum() Series
+	// Count calculates the number of non-NaN values of the rolling series,
+	// NaN when there are fewer than minPeriods non-NaN values in the window.
+	Count() Series
 	// Apply applies a function for the rolling series
 	Apply(f func(window Series, windowIndex int) interface{}, t Type) Series
+	// ApplyFloat applies a float aggregation function for the rolling series and returns a Float Series,
+	// the windows with fewer than minPeriods non-NaN values yield NaN without calling f.
+	ApplyFloat(f func(window Series) float64) Series
 	//Iterate iterates the rolling series, the window series is nil when minPeriods is less than the window size
 	Iterate(f func(window Series, windowIndex int))
+	// Center returns the rolling series with the window centered on each element instead of
+	// trailing it, the window of the i-th element is [i-window/2, i+(window-1)/2] as pandas center=True.
+	Center() RollingSeries
 }
 
 type rollingSeries struct {
 	Series
 	window     int
 	minPeriods int
+	center     bool
 }
 
 //RollingWindow define rolling window
@@ -93,6 +134,32 @@ func newRollingSeries(window int, minPeriods int, s Series) RollingSeries {
 	}
 }
 
+func (s rollingSeries) Center() RollingSeries {
+	s.center = true
+	return s
+}
+
+// offset returns how many elements after the i-th element its window covers.
+func (s rollingSeries) offset() int {
+	if s.center {
+		return (s.window - 1) / 2
+	}
+	return 0
+}
+
+// bounds returns the window [start, end) of the i-th element.
+func (s rollingSeries) bounds(i int) (start, end int) {
+	end = i + 1 + s.offset()
+	start = end - s.window
+	if start < 0 {
+		start = 0
+	}
+	if end > s.Len() {
+		end = s.Len()
+	}
+	return start, end
+}
+
 func (s rollingSeries) Max() Series {
 
 	var maxFunc func(window Series, windowIndex int) interface{}
@@ -128,6 +195,22 @@ func (s rollingSeries) Min() Series {
 	return newS
 }
 
+func (s rollingSeries) ArgMax() Series {
+	newS := s.ApplyFloat(func(window Series) float64 {
+		return float64(window.ArgMax())
+	})
+	newS.SetName(fmt.Sprintf("%s_RArgMax[w:%d]", s.Name(), s.window))
+	return newS
+}
+
+func (s rollingSeries) ArgMin() Series {
+	newS := s.ApplyFloat(func(window Series) float64 {
+		return float64(window.ArgMin())
+	})
+	newS.SetName(fmt.Sprintf("%s_RArgMin[w:%d]", s.Name(), s.window))
+	return newS
+}
+
 func (s rollingSeries) Mean() Series {
 	newS := s.Apply(func(window Series, windowIndex int) interface{} {
 		return window.Mean()
@@ -137,28 +220,29 @@ func (s rollingSeries) Mean() Series {
 }
 
 func (s rollingSeries) MeanByWeights(weights []float64) Series {
+	newS := s.ApplyWeighted(weights, func(vals, weights []float64) float64 {
+		totalSum := 0.0
+		for i := 0; i < len(vals); i++ {
+			totalSum += weights[i] * vals[i]
+		}
+		return totalSum / floats.Sum(weights)
+	})
+	newS.SetName(fmt.Sprintf("%s_RMeanByWeights[w:%d,%v]", s.Name(), s.window, weights))
+	return newS
+}
+
+func (s rollingSeries) ApplyWeighted(weights []float64, f func(vals, weights []float64) float64) Series {
 	if s.window != len(weights) {
 		panic("window must be equal to weights length")
 	}
-	weightSum := floats.Sum(weights)
-	weightLen := len(weights)
 	newS := s.Apply(
 		func(window Series, windowIndex int) interface{} {
-			weightsUse := weights
-			weightSumUse := weightSum
-			wfL := window.Len()
-			if wfL < weightLen {
-				weightsUse = weights[weightLen-wfL:]
-				weightSumUse = floats.Sum(weightsUse)
-			}
-			totalSum := 0.0
-			windowFloats := window.Float()
-			for i := 0; i < wfL; i++ {
-				totalSum += weightsUse[i] * windowFloats[i]
-			}
-			return totalSum / weightSumUse
+			// the weights cut off with the window at the edges of the series
+			start, _ := s.bounds(windowIndex)
+			first := start + s.window - (windowIndex + 1 + s.offset())
+			return f(window.Float(), weights[first:first+window.Len()])
 		}, Float)
-	newS.SetName(fmt.Sprintf("%s_RMeanByWeights[w:%d,%v]", s.Name(), s.window, weights))
+	newS.SetName(fmt.Sprintf("%s_RApplyWeighted[w:%d,%v]", s.Name(), s.window, weights))
 	return newS
 }
 
@@ -185,7 +269,7 @@ func (s rollingSeries) Quantiles(ps ...float64) []Series {
 	s.Iterate(func(window Series, windowIndex int) {
 		if window == nil {
 			for i := 0; i < len(ps); i++ {
-				ret[i].Elem(windowIndex).SetString(NaN)
+				ret[i].Elem(windowIndex).SetNA()
 			}
 		} else {
 			qs := window.Quantiles(ps...)
@@ -223,12 +307,124 @@ func (s rollingSeries) DataQuantileRolling(data Series) Series {
 	return newS
 }
 
+// Median keeps the elements of the window in an order statistic tree, so each
+// step costs O(log n) instead of sorting the whole window. Like Series.Median,
+// the NaN elements are ordered after the other elements of the window.
 func (s rollingSeries) Median() Series {
-	newS := s.Apply(func(window Series, windowIndex int) interface{} {
-		return window.Median()
-	}, Float)
-	newS.SetName(fmt.Sprintf("%s_RMedian[w:%d]", s.Name(), s.window))
-	return newS
+	if s.Len() == 0 || s.Type() == String || s.Type() == Bool {
+		newS := s.Apply(func(window Series, windowIndex int) interface{} {
+			return window.Median()
+		}, Float)
+		newS.SetName(fmt.Sprintf("%s_RMedian[w:%d]", s.Name(), s.window))
+		return newS
+	}
+
+	eles := Float.emptyElements(s.Len())
+	tree := newOrderStatisticTree(s.Float())
+	// the tree holds the elements [removed, added)
+	added, removed := 0, 0
+	for i := 0; i < s.Len(); i++ {
+		start, end := s.bounds(i)
+		for ; added < end; added++ {
+			tree.add(added)
+		}
+		for ; removed < start; removed++ {
+			tree.remove(removed)
+		}
+		size := end - start
+		if size < s.minPeriods {
+			eles.Elem(i).SetNA()
+			continue
+		}
+		median := tree.kth(size / 2)
+		if size%2 == 0 {
+			median = (tree.kth(size/2-1) + median) * 0.5
+		}
+		eles.Elem(i).SetFloat(median)
+	}
+	return &series{
+		name:     fmt.Sprintf("%s_RMedian[w:%d]", s.Name(), s.window),
+		elements: eles,
+		t:        Float,
+		err:      nil,
+	}
+}
+
+// orderStatisticTree is a Fenwick tree over the ranks of the non-NaN values,
+// it counts the values added and finds the k-th smallest one in O(log n).
+type orderStatisticTree struct {
+	values []float64
+	// rank of each index in the sorted non-NaN values, -1 for NaN
+	rank   []int
+	byRank []int
+	tree   []int
+	count  int
+}
+
+func newOrderStatisticTree(values []float64) *orderStatisticTree {
+	byRank := make([]int, 0, len(values))
+	for i, v := range values {
+		if !math.IsNaN(v) {
+			byRank = append(byRank, i)
+		}
+	}
+	sort.SliceStable(byRank, func(i, j int) bool {
+		return values[byRank[i]] < values[byRank[j]]
+	})
+	rank := make([]int, len(values))
+	for i := range rank {
+		rank[i] = -1
+	}
+	for r, i := range byRank {
+		rank[i] = r
+	}
+	return &orderStatisticTree{
+		values: values,
+		rank:   rank,
+		byRank: byRank,
+		tree:   make([]int, len(byRank)+1),
+	}
+}
+
+func (t *orderStatisticTree) update(i int, delta int) {
+	r := t.rank[i]
+	if r < 0 {
+		return
+	}
+	t.count += delta
+	for r++; r < len(t.tree); r += r & -r {
+		t.tree[r] += delta
+	}
+}
+
+// add adds the value at index i.
+func (t *orderStatisticTree) add(i int) {
+	t.update(i, 1)
+}
+
+// remove removes the value at index i.
+func (t *orderStatisticTree) remove(i int) {
+	t.update(i, -1)
+}
+
+// kth returns the k-th (0-based) smallest value added, NaN when k is not less
+// than the number of non-NaN values added.
+func (t *orderStatisticTree) kth(k int) float64 {
+	if k >= t.count {
+		return math.NaN()
+	}
+	pos := 0
+	step := 1
+	for step*2 < len(t.tree) {
+		step *= 2
+	}
+	for ; step > 0; step /= 2 {
+		if pos+step < len(t.tree) && t.tree[pos+step] <= k {
+			pos += step
+			k -= t.tree[pos]
+		}
+	}
+	return t.values[t.byRank[pos]]
 }
 
 func (s rollingSeries) StdDev() Series {
@@ -239,6 +435,115 @@ func (s rollingSeries) StdDev() Series {
 	return newS
 }
 
+func (s rollingSeries) Zscore() Series {
+	means := s.Mean().Float()
+	stds := s.StdDev().Float()
+	xs := s.Float()
+	zs := make([]float64, s.Len())
+	for i, x := range xs {
+		switch {
+		case math.IsNaN(x) || math.IsNaN(means[i]):
+			zs[i] = math.NaN()
+		case stds[i] == 0 || math.IsNaN(stds[i]):
+			zs[i] = 0
+		default:
+			zs[i] = (x - means[i]) / stds[i]
+		}
+	}
+	return New(zs, Float, fmt.Sprintf("%s_RZscore[w:%d]", s.Name(), s.window))
+}
+
+// Var returns the variance of the non-NaN values of each window, NaN when they are fewer than minPeriods.
+func (s rollingSeries) Var() Series {
+	newS := s.ApplyFloat(func(window Series) float64 {
+		return stat.Variance(window.DropNaN().Float(), nil)
+	})
+	newS.SetName(fmt.Sprintf("%s_RVar[w:%d]", s.Name(), s.window))
+	return newS
+}
+
+// Skew returns the skewness of the non-NaN values of each window, NaN when they are fewer than minPeriods.
+func (s rollingSeries) Skew() Series {
+	newS := s.ApplyFloat(func(window Series) float64 {
+		return stat.Skew(window.DropNaN().Float(), nil)
+	})
+	newS.SetName(fmt.Sprintf("%s_RSkew[w:%d]", s.Name(), s.window))
+	return newS
+}
+
+func (s rollingSeries) Corr(other Series) Series {
+	return s.applyPairwise(other, fmt.Sprintf("%s_RCorr[w:%d,other:%s]", s.Name(), s.window, other.Name()),
+		func(x, y []float64) float64 {
+			return stat.Correlation(x, y, nil)
+		})
+}
+
+func (s rollingSeries) Cov(other Series) Series {
+	return s.applyPairwise(other, fmt.Sprintf("%s_RCov[w:%d,other:%s]", s.Name(), s.window, other.Name()),
+		func(x, y []float64) float64 {
+			return stat.Covariance(x, y, nil)
+		})
+}
+
+// applyPairwise applies f to the non-NaN pairs of each window of the rolling series
+// and the window of other at the same position, and returns a Float Series.
+func (s rollingSeries) applyPairwise(other Series, name string, f func(x, y []float64) float64) Series {
+	if s.Len() != other.Len() {
+		ret := New([]float64{}, Float, name)
+		ret.SetErr(fmt.Errorf("rolling error: length mismatch (%d != %d)", s.Len(), other.Len()))
+		return ret
+	}
+	xs := s.Float()
+	ys := other.Float()
+	x := make([]float64, 0, s.window)
+	y := make([]float64, 0, s.window)
+	eles := Float.emptyElements(s.Len())
+	for i := 0; i < s.Len(); i++ {
+		start, end := s.bounds(i)
+		x, y = x[:0], y[:0]
+		for j := start; j < end; j++ {
+			if !math.IsNaN(xs[j]) && !math.IsNaN(ys[j]) {
+				x = append(x, xs[j])
+				y = append(y, ys[j])
+			}
+		}
+		if len(x) < s.minPeriods {
+			eles.Elem(i).SetNA()
+			continue
+		}
+		eles.Elem(i).SetFloat(f(x, y))
+	}
+	return &series{
+		name:     name,
+		elements: eles,
+		t:        Float,
+		err:      nil,
+	}
+}
+
+func (s rollingSeries) Sum() Series {
+	newS := s.Apply(func(window Series, windowIndex int) interface{} {
+		if window.Count() < s.minPeriods {
+			return NaN
+		}
+		return window.SumSkipNaN()
+	}, Float)
+	newS.SetName(fmt.Sprintf("%s_RSum[w:%d]", s.Name(), s.window))
+	return newS
+}
+
+func (s rollingSeries) Count() Series {
+	newS := s.Apply(func(window Series, windowIndex int) interface{} {
+		count := window.Count()
+		if count < s.minPeriods {
+			return NaN
+		}
+		return count
+	}, Float)
+	newS.SetName(fmt.Sprintf("%s_RCount[w:%d]", s.Name(), s.window))
+	return newS
+}
+
 func (s rollingSeries) Apply(f func(window Series, windowIndex int) interface{}, t Type) Series {
 	if s.Len() == 0 {
 		return s.Empty()
@@ -247,16 +552,13 @@ func (s rollingSeries) Apply(f func(window Series, windowIndex int) interface{},
 		t = s.Type()
 	}
 	eles := t.emptyElements(s.Len())
-	index := 0
-	rw := NewRollingWindow(s.Series, s.window)
-	for rw.HasNext() {
-		window := rw.NextWindow()
+	for index := 0; index < s.Len(); index++ {
+		window := s.Series.Slice(s.bounds(index))
 		if window.Len() >= s.minPeriods {
 			eles.Elem(index).Set(f(window, index))
 		} else {
-			eles.Elem(index).Set(NaN)
+			eles.Elem(index).SetNA()
 		}
-		index++
 	}
 	newS := &series{
 		name:     fmt.Sprintf("%s_RApply[w:%d]", s.Name(), s.window),
@@ -267,16 +569,24 @@ func (s rollingSeries) Apply(f func(window Series, windowIndex int) interface{},
 	return newS
 }
 
+func (s rollingSeries) ApplyFloat(f func(window Series) float64) Series {
+	newS := s.Apply(func(window Series, windowIndex int) interface{} {
+		if window.Count() < s.minPeriods {
+			return NaN
+		}
+		return f(window)
+	}, Float)
+	newS.SetName(fmt.Sprintf("%s_RApplyFloat[w:%d]", s.Name(), s.window))
+	return newS
+}
+
 func (s rollingSeries) Iterate(f func(window Series, windowIndex int)) {
-	index := 0
-	rw := NewRollingWindow(s.Series, s.window)
-	for rw.HasNext() {
-		window := rw.NextWindow()
+	for index := 0; index < s.Len(); index++ {
+		window := s.Series.Slice(s.bounds(index))
 		if window.Len() >= s.minPeriods {
 			f(window, index)
 		} else {
 			f(nil, index)
 		}
-		index++
 	}
 }
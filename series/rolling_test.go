@@ -1,7 +1,9 @@
 package series
 
 import (
+	"math/rand"
 	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -169,6 +171,60 @@ func TestSeries_MeanByWeights(t *testing.T) {
 	}
 }
 
+func TestSeries_RollingApplyWeighted(t *testing.T) {
+	weightedSum := func(vals, weights []float64) float64 {
+		if len(vals) != len(weights) {
+			return -1
+		}
+		sum := 0.0
+		for i := range vals {
+			sum += vals[i] * weights[i]
+		}
+		return sum
+	}
+	tests := []struct {
+		series    Series
+		window    int
+		minPeriod int
+		weights   []float64
+		expected  Series
+	}{
+		{
+			Floats([]string{"1", "2", NaN, "4"}),
+			2,
+			1,
+			[]float64{1, 10},
+			Floats([]string{"10", "21", NaN, NaN}),
+		},
+		{
+			Ints([]int{1, 2, 3, 4}),
+			3,
+			2,
+			[]float64{0.5, 0.25, 0.25},
+			Floats([]string{NaN, "0.75", "1.75", "2.75"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.expected.Records()
+		b := test.series.Rolling(test.window, test.minPeriod).ApplyWeighted(test.weights, weightedSum)
+		received := b.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-ApplyWeighted:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+
+	defer func() {
+		if err := recover(); err == nil {
+			t.Errorf("Expected panic on weights length mismatch")
+		}
+	}()
+	Ints([]int{1, 2, 3}).Rolling(2, 1).ApplyWeighted([]float64{1}, weightedSum)
+}
+
 func TestSeries_RollingApply(t *testing.T) {
 	tests := []struct {
 		series       Series
@@ -224,3 +280,433 @@ func TestSeries_RollingApply(t *testing.T) {
 	}
 }
 
+
+func TestSeries_RollingSumCount(t *testing.T) {
+	tests := []struct {
+		series        Series
+		window        int
+		minPeriod     int
+		sumExpected   Series
+		countExpected Series
+	}{
+		{
+			Floats([]string{"1.5", NaN, "-0.5", "2", NaN, NaN}),
+			3,
+			2,
+			Floats([]string{NaN, NaN, "1", "1.5", "1.5", NaN}),
+			Floats([]string{NaN, NaN, "2", "2", "2", NaN}),
+		},
+		{
+			Ints([]string{"23", "13", "101", "-64", "-3"}),
+			2,
+			1,
+			Floats([]string{"23", "36", "114", "37", "-67"}),
+			Floats([]string{"1", "2", "2", "2", "2"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		for _, rs := range []RollingSeries{
+			test.series.Rolling(test.window, test.minPeriod),
+			test.series.CacheAble().Rolling(test.window, test.minPeriod),
+		} {
+			expected := test.sumExpected.Records()
+			_ = rs.Sum()
+			received := rs.Sum().Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-Sum:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+
+			expected = test.countExpected.Records()
+			_ = rs.Count()
+			received = rs.Count().Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-Count:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+		}
+	}
+}
+
+func TestSeries_RollingVarSkew(t *testing.T) {
+	tests := []struct {
+		series       Series
+		window       int
+		minPeriod    int
+		varExpected  Series
+		skewExpected Series
+	}{
+		{
+			Floats([]string{"1", "2", "4", "8", "9"}),
+			3,
+			2,
+			Floats([]string{NaN, "0.5", "2.333333", "9.333333", "7"}),
+			Floats([]string{NaN, NaN, "0.935220", "0.935220", "-1.457863"}),
+		},
+		{
+			Ints([]string{"23", "13", "101", "-64", "-3"}),
+			2,
+			1,
+			Floats([]string{NaN, "50", "3872", "13612.5", "1860.5"}),
+			Floats([]string{NaN, NaN, NaN, NaN, NaN}),
+		},
+		{
+			Floats([]string{"1", NaN, "3", "5", "10"}),
+			3,
+			2,
+			Floats([]string{NaN, NaN, "2", "2", "13"}),
+			Floats([]string{NaN, NaN, NaN, NaN, "1.152070"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		for _, rs := range []RollingSeries{
+			test.series.Rolling(test.window, test.minPeriod),
+			test.series.CacheAble().Rolling(test.window, test.minPeriod),
+		} {
+			expected := test.varExpected.Records()
+			_ = rs.Var()
+			received := rs.Var().Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-Var:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+
+			expected = test.skewExpected.Records()
+			_ = rs.Skew()
+			received = rs.Skew().Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-Skew:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+		}
+	}
+}
+
+func TestSeries_RollingApplyFloat(t *testing.T) {
+	tests := []struct {
+		series        Series
+		window        int
+		minPeriod     int
+		applyFunc     func(window Series) float64
+		applyExpected Series
+	}{
+		{
+			Floats([]string{"1", NaN, "4", "8", "9"}),
+			3,
+			2,
+			func(window Series) float64 {
+				valid := window.DropNaN()
+				return valid.Max() - valid.Min()
+			},
+			Floats([]string{NaN, NaN, "3", "4", "5"}),
+		},
+		{
+			Ints([]string{"23", "13", "101", "-64", "-3"}),
+			2,
+			1,
+			func(window Series) float64 {
+				return float64(window.Len())
+			},
+			Floats([]string{"1", "2", "2", "2", "2"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.applyExpected.Records()
+		b := test.series.Rolling(test.window, test.minPeriod).ApplyFloat(test.applyFunc)
+		received := b.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-ApplyFloat:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_RollingMedian(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomRecords := func(n int) []string {
+		records := make([]string, n)
+		for i := range records {
+			if r.Intn(5) == 0 {
+				records[i] = NaN
+			} else {
+				records[i] = strconv.Itoa(r.Intn(20) - 10)
+			}
+		}
+		return records
+	}
+	tests := []struct {
+		series    Series
+		window    int
+		minPeriod int
+	}{
+		{Floats(randomRecords(200)), 7, 1},
+		{Floats(randomRecords(200)), 10, 4},
+		{Ints(randomRecords(200)), 1, 1},
+		{Ints(randomRecords(200)), 50, 50},
+		{Floats(randomRecords(5)), 10, 3},
+		{Floats([]string{NaN, NaN, NaN}), 2, 1},
+		{Floats([]float64{}), 2, 1},
+	}
+
+	for testnum, test := range tests {
+		rolling := test.series.Rolling(test.window, test.minPeriod)
+		for _, rs := range []RollingSeries{rolling, rolling.Center()} {
+			// windows sorted from scratch
+			expected := rs.Apply(func(window Series, windowIndex int) interface{} {
+				return window.Median()
+			}, Float).Records()
+			b := rs.Median()
+			received := b.Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-Median:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+			if test.series.Len() > 0 && b.Type() != Float {
+				t.Errorf("Test:%v\nExpected type %v, received %v", testnum, Float, b.Type())
+			}
+		}
+	}
+}
+
+func TestSeries_RollingCorrCov(t *testing.T) {
+	tests := []struct {
+		series       Series
+		other        Series
+		window       int
+		minPeriod    int
+		corrExpected Series
+		covExpected  Series
+	}{
+		{
+			Ints([]int{1, 2, 3, 4, 5, 6}),
+			Floats([]string{"2", "4", "5", "4", NaN, "1"}),
+			3,
+			2,
+			Floats([]string{NaN, "1", "0.981981", "0", "-1", "-1"}),
+			Floats([]string{NaN, "1", "1.5", "0", "-0.5", "-3"}),
+		},
+		{
+			Floats([]string{"1", NaN, "3"}),
+			Floats([]float64{1, 1, 1}),
+			2,
+			1,
+			Floats([]string{NaN, NaN, NaN}),
+			Floats([]string{NaN, NaN, NaN}),
+		},
+	}
+
+	for testnum, test := range tests {
+		for _, rs := range []RollingSeries{
+			test.series.Rolling(test.window, test.minPeriod),
+			test.series.CacheAble().Rolling(test.window, test.minPeriod),
+		} {
+			expected := test.corrExpected.Records()
+			b := rs.Corr(test.other)
+			if err := b.Error(); err != nil {
+				t.Errorf("Test-Corr:%v\nError:%v", testnum, err)
+			}
+			received := b.Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-Corr:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+
+			expected = test.covExpected.Records()
+			b = rs.Cov(test.other)
+			if err := b.Error(); err != nil {
+				t.Errorf("Test-Cov:%v\nError:%v", testnum, err)
+			}
+			received = b.Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-Cov:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+		}
+	}
+
+	rs := Ints([]int{1, 2, 3}).Rolling(2, 1)
+	if rs.Corr(Ints([]int{1, 2})).Error() == nil || rs.Cov(Ints([]int{1, 2})).Error() == nil {
+		t.Errorf("Expected error on length mismatch")
+	}
+}
+
+func TestSeries_RollingCenter(t *testing.T) {
+	tests := []struct {
+		series           Series
+		window           int
+		minPeriod        int
+		meanExpected     Series
+		weights          []float64
+		weightedExpected Series
+	}{
+		{
+			Ints([]int{1, 2, 3, 4, 5}),
+			3,
+			1,
+			Floats([]string{"1.5", "2", "3", "4", "4.5"}),
+			[]float64{1, 10, 100},
+			Floats([]string{"210", "321", "432", "543", "54"}),
+		},
+		{
+			Ints([]int{1, 2, 3, 4, 5}),
+			4,
+			2,
+			Floats([]string{"1.5", "2", "2.5", "3.5", "4"}),
+			[]float64{1, 10, 100, 1000},
+			Floats([]string{"2100", "3210", "4321", "5432", "543"}),
+		},
+		{
+			Floats([]float64{1, 2, 3, 4, 5}),
+			3,
+			3,
+			Floats([]string{NaN, "2", "3", "4", NaN}),
+			[]float64{1, 1, 1},
+			Floats([]string{NaN, "6", "9", "12", NaN}),
+		},
+	}
+
+	for testnum, test := range tests {
+		cacheAble := test.series.CacheAble().Rolling(test.window, test.minPeriod)
+		trailing := cacheAble.Mean().Records()
+		for _, rs := range []RollingSeries{
+			test.series.Rolling(test.window, test.minPeriod).Center(),
+			cacheAble.Center(),
+		} {
+			expected := test.meanExpected.Records()
+			_ = rs.Mean()
+			received := rs.Mean().Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-Mean:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+
+			expected = test.weightedExpected.Records()
+			received = rs.ApplyWeighted(test.weights, func(vals, weights []float64) float64 {
+				sum := 0.0
+				for i := range vals {
+					sum += vals[i] * weights[i]
+				}
+				return sum
+			}).Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-ApplyWeighted:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+		}
+		if received := cacheAble.Mean().Records(); !reflect.DeepEqual(trailing, received) {
+			t.Errorf(
+				"Test-Trailing:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, trailing, received,
+			)
+		}
+	}
+}
+
+func TestSeries_RollingZscore(t *testing.T) {
+	tests := []struct {
+		series    Series
+		window    int
+		minPeriod int
+		expected  Series
+	}{
+		{
+			Floats([]string{"1", "2", "4", "8", "8", NaN, "3"}),
+			3,
+			2,
+			Floats([]string{NaN, "0.707107", "1.091089", "1.091089", "0.577350", NaN, NaN}),
+		},
+		{
+			Ints([]int{5, 5, 5, 6}),
+			2,
+			1,
+			Floats([]string{"0", "0", "0", "0.707107"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		for _, rs := range []RollingSeries{
+			test.series.Rolling(test.window, test.minPeriod),
+			test.series.CacheAble().Rolling(test.window, test.minPeriod),
+		} {
+			expected := test.expected.Records()
+			_ = rs.Zscore()
+			received := rs.Zscore().Records()
+			if !reflect.DeepEqual(expected, received) {
+				t.Errorf(
+					"Test-Zscore:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+		}
+	}
+}
+
+func TestSeries_RollingArgMaxArgMin(t *testing.T) {
+	tests := []struct {
+		series    Series
+		window    int
+		minPeriod int
+		argMax    Series
+		argMin    Series
+	}{
+		{
+			Floats([]string{"3", "1", "4", "1", "5", NaN, "2"}),
+			3,
+			2,
+			Floats([]string{NaN, "0", "2", "1", "2", "1", "0"}),
+			Floats([]string{NaN, "1", "1", "0", "1", "0", "2"}),
+		},
+		{
+			Ints([]int{2, 2, 1}),
+			2,
+			1,
+			Floats([]float64{0, 0, 0}),
+			Floats([]float64{0, 0, 1}),
+		},
+	}
+
+	for testnum, test := range tests {
+		for _, rs := range []RollingSeries{
+			test.series.Rolling(test.window, test.minPeriod),
+			test.series.CacheAble().Rolling(test.window, test.minPeriod),
+		} {
+			received := rs.ArgMax()
+			if received.Type() != Float || !reflect.DeepEqual(test.argMax.Records(), received.Records()) {
+				t.Errorf(
+					"Test-ArgMax:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, test.argMax, received,
+				)
+			}
+			received = rs.ArgMin()
+			if received.Type() != Float || !reflect.DeepEqual(test.argMin.Records(), received.Records()) {
+				t.Errorf(
+					"Test-ArgMin:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, test.argMin, received,
+				)
+			}
+		}
+	}
+}
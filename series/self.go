@@ -1,6 +1,10 @@
 package series
 
+import "math"
+
 // All the operations on it will influence the Series's content.
+// Apply, Map, AddConst, MulConst and Abs modify the elements of the Series in place and
+// allocate no new elements.
 type Self struct {
 	this Series
 }
@@ -19,3 +23,57 @@ func (s Self) Apply(f func(ele Element, index int)) {
 		f(s.this.Elem(i), i)
 	}
 }
+
+// Map applies a function matching MapFunction signature to the element of a Series and sets
+// the element to the returned value converted to the type of the Series, will influence the Series's content.
+// Unlike Series.Map, no new Series is allocated.
+func (s Self) Map(f MapFunction) {
+	for i := 0; i < s.this.Len(); i++ {
+		ele := s.this.Elem(i)
+		ele.SetElement(f(ele, i))
+	}
+}
+
+// AddConst adds the scalar c to the numeric elements of a Series, will influence the Series's content.
+// The results of an Int or Int64 Series are truncated toward zero, so a fractional c like 0.5 may leave them unchanged.
+// NaN elements and the elements of non-numeric Series are left unchanged.
+func (s Self) AddConst(c float64) {
+	s.applyFloat(func(f float64) float64 {
+		return f + c
+	})
+}
+
+// MulConst multiplies the numeric elements of a Series by the scalar c, will influence the Series's content.
+// The results of an Int or Int64 Series are truncated toward zero like AddConst.
+// NaN elements and the elements of non-numeric Series are left unchanged.
+func (s Self) MulConst(c float64) {
+	s.applyFloat(func(f float64) float64 {
+		return f * c
+	})
+}
+
+// Abs sets the numeric elements of a Series to their absolute value, will influence the Series's content.
+// Int and Int64 elements are computed in integer space, the lowest value has no absolute value and is set to NaN.
+// NaN elements and the elements of non-numeric Series are left unchanged.
+func (s Self) Abs() {
+	if t := s.this.Type(); t == Int || t == Int64 {
+		s.Apply(func(ele Element, index int) {
+			absInt(ele)
+		})
+		return
+	}
+	s.applyFloat(math.Abs)
+}
+
+// applyFloat sets the non-NaN elements of an Int, Int64 or Float Series to f of their value,
+// the results of Int and Int64 Series are truncated by SetFloat.
+func (s Self) applyFloat(f func(float64) float64) {
+	if !s.this.Type().numeric() {
+		return
+	}
+	s.Apply(func(ele Element, index int) {
+		if !ele.IsNA() {
+			ele.SetFloat(f(ele.Float()))
+		}
+	})
+}
@@ -2,6 +2,7 @@ package series
 
 import (
 	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -39,4 +40,129 @@ func TestSeries_Self_Apply(t *testing.T) {
 			)
 		}
 	}
-}
\ No newline at end of file
+}
+func TestSeries_Self_Map(t *testing.T) {
+	tests := []struct {
+		series   Series
+		f        MapFunction
+		expected Series
+	}{
+		{
+			Floats([]string{"1.5", "-3.23", NaN}),
+			func(ele Element, index int) Element {
+				ret := ele.Copy()
+				ret.SetFloat(ele.Float() * 2)
+				return ret
+			},
+			Floats([]string{"3", "-6.46", NaN}),
+		},
+		{
+			Ints([]string{"23", "13", "101"}),
+			func(ele Element, index int) Element {
+				return Floats([]float64{float64(index) + 0.5}).Elem(0)
+			},
+			Ints([]string{"0", "1", "2"}),
+		},
+		{
+			Strings([]string{"a", "b"}),
+			func(ele Element, index int) Element {
+				ele.SetString(ele.String() + "!")
+				return ele
+			},
+			Strings([]string{"a!", "b!"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.expected.Records()
+		b := test.series.Copy()
+		e := b.Elem(0)
+		b.Self().Map(test.f)
+		received := b.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		if e != b.Elem(0) || e.String() != expected[0] {
+			t.Errorf("Test:%v\nExpected the elements to be modified in place", testnum)
+		}
+	}
+}
+
+func TestSeries_Self_ScalarOps(t *testing.T) {
+	tests := []struct {
+		series   Series
+		ops      func(self Self)
+		expected Series
+	}{
+		{
+			Floats([]string{"1.5", "-3.25", NaN}),
+			func(self Self) {
+				self.AddConst(1)
+				self.MulConst(2)
+				self.Abs()
+			},
+			Floats([]string{"5", "4.5", NaN}),
+		},
+		{
+			Ints([]string{"-9223372036854775807", "-3", NaN}),
+			func(self Self) {
+				self.Abs()
+			},
+			Ints([]string{"9223372036854775807", "3", NaN}),
+		},
+		{
+			Ints([]string{strconv.Itoa(-1 << (strconv.IntSize - 1)), "-1"}),
+			func(self Self) {
+				self.Abs()
+			},
+			Ints([]string{NaN, "1"}),
+		},
+		{
+			Ints([]int{1, -2}),
+			func(self Self) {
+				self.MulConst(-1.5)
+				self.AddConst(0.5)
+			},
+			Ints([]int{0, 3}),
+		},
+		{
+			Int64s([]string{"-9223372036854775807", "-9223372036854775808", NaN}),
+			func(self Self) {
+				self.Abs()
+			},
+			Int64s([]string{"9223372036854775807", NaN, NaN}),
+		},
+		{
+			Int64s([]int64{1, -2}),
+			func(self Self) {
+				self.MulConst(-1.5)
+				self.AddConst(0.5)
+			},
+			Int64s([]int64{0, 3}),
+		},
+		{
+			Strings([]string{"a", "-1"}),
+			func(self Self) {
+				self.AddConst(1)
+				self.Abs()
+			},
+			Strings([]string{"a", "-1"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.expected.Records()
+		original := test.series
+		test.ops(original.Self())
+		received := original.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
@@ -4,10 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
+	"runtime"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"math"
+	"math/cmplx"
 
 	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/stat"
@@ -49,6 +54,8 @@ type Element interface {
 	SetFloat(val float64)
 	SetInt(val int)
 	SetString(val string)
+	// SetNA marks the element as missing.
+	SetNA()
 
 	// Comparation methods
 	Eq(Element) bool
@@ -73,16 +80,36 @@ type Element interface {
 
 type Series interface {
 	Rolling(window int, minPeriods int) RollingSeries
+	// Expanding returns an expanding series, the window of the i-th element is [0, i].
+	Expanding(minPeriods int) ExpandingSeries
+	// EWM calculates the exponentially weighted moving average of the series with
+	// alpha = 2/(span+1) and returns a Float Series. NaN elements are skipped in the recursion.
+	EWM(span float64) Series
 	// HasNaN checks whether the Series contain NaN elements.
 	HasNaN() bool
 	// IsNaN returns an array that identifies which of the elements are NaN.
 	IsNaN() []bool
 	// IsNotNaN returns an array that identifies which of the elements are not NaN.
 	IsNotNaN() []bool
+	// FirstValidIndex returns the index of the first non-NaN element, or -1 if there is none.
+	FirstValidIndex() int
+	// LastValidIndex returns the index of the last non-NaN element, or -1 if there is none.
+	LastValidIndex() int
+	// Count returns the number of non-NaN elements of the series.
+	Count() int
+	// NUnique returns the number of distinct non-NaN elements of the series.
+	NUnique() int
 	// Compare compares the values of a Series with other elements. To do so, the
 	// elements with are to be compared are first transformed to a Series of the same
 	// type as the caller.
 	Compare(comparator Comparator, comparando interface{}) Series
+	// IsIn returns a Bool Series that identifies which of the elements are in values,
+	// it is a shortcut for Compare(In, values).
+	IsIn(values interface{}) Series
+	// IsInSet returns a Bool Series that identifies which of the elements are in set,
+	// only the keys mapped to true are members. The keys are first transformed to the
+	// type of the caller, the membership of each element is then checked in O(1).
+	IsInSet(set map[interface{}]bool) Series
 	// Float returns the elements of a Series as a []float64. If the elements can not
 	// be converted to float64 or contains a NaN returns the float representation of
 	// NaN.
@@ -93,32 +120,126 @@ type Series interface {
 	// Int returns the elements of a Series as a []int or an error if the
 	// transformation is not possible.
 	Int() ([]int, error)
+	// ToSlice returns the elements of a Series as a native slice according to its type:
+	// []int, []int64, []float64, []complex128, []bool, []time.Time or []string. NaN elements
+	// of Int, Int64, Bool and DateTime series are returned as the zero value, those of a
+	// Complex series as NaN.
+	ToSlice() interface{}
+	// Codes returns the dictionary codes of the elements of a Categorical series, NaN elements are -1.
+	// It returns nil for the other types.
+	Codes() []int
+	// Categories returns the dictionary of a Categorical series indexed by the codes, it may contain
+	// the unused categories of the series it was copied from. It returns nil for the other types.
+	Categories() []string
 	// Order returns the indexes for sorting a Series. NaN elements are pushed to the
-	// end by order of appearance.
+	// end by order of appearance. Complex elements are not ordered so they keep their order of appearance.
 	Order(reverse bool) []int
 	// StdDev calculates the standard deviation of a series
 	StdDev() float64
+	// StdDevPopulation calculates the population standard deviation of a series, dividing by n
+	// instead of the n-1 of StdDev, which is the default of numpy.
+	StdDevPopulation() float64
+	// Skew calculates the sample skewness of a series.
+	// Returns NaN for String and Bool series.
+	Skew() float64
+	// Kurtosis calculates the sample excess kurtosis of a series.
+	// Returns NaN for String and Bool series.
+	Kurtosis() float64
+	// MeanAD calculates the mean absolute deviation about the mean of the non-NaN elements.
+	// Returns NaN for String and Bool series.
+	MeanAD() float64
+	// SEM calculates the standard error of the mean StdDev() / sqrt(Count()) of the non-NaN elements.
+	// Returns NaN for String series.
+	SEM() float64
+	// MedianAD calculates the median absolute deviation about the median of the non-NaN elements.
+	// Returns NaN for String and Bool series.
+	MedianAD() float64
+	// IQR returns the interquartile range Quantile(0.75) - Quantile(0.25) of the non-NaN elements.
+	IQR() float64
+	// OutlierMask returns a Bool Series flagging the elements outside the Tukey fences
+	// [Q1 - k*IQR, Q3 + k*IQR] of the non-NaN elements. NaN elements are not flagged.
+	OutlierMask(k float64) Series
+	// Histogram returns the counts of the non-NaN elements in bins equal-width bins between
+	// Min and Max, and the bins+1 edges of the bins. The bins are right-open except the last one.
+	// If all the elements are equal they are counted in the first bin.
+	// Returns nil if bins < 1, the series is not numeric or has no non-NaN element.
+	Histogram(bins int) (counts []int, edges []float64)
+	// Cov returns the sample covariance between the series and other.
+	// Pairs where either element is NaN are skipped.
+	// Returns NaN if the lengths mismatch or any of the series is not numeric.
+	Cov(other Series) float64
+	// Corr returns the Pearson correlation between the series and other.
+	// Pairs where either element is NaN are skipped.
+	// Returns NaN if the lengths mismatch or any of the series is not numeric.
+	Corr(other Series) float64
+	// Autocorr returns the Pearson correlation between the series and itself shifted by lag.
+	// The NaN elements introduced by shifting are skipped pairwise.
+	Autocorr(lag int) float64
+	// Dot returns the inner product of the series and other.
+	// Returns NaN if the lengths mismatch or any of the series is not numeric.
+	Dot(other Series) float64
 	// Mean calculates the average value of a series
 	Mean() float64
+	// MeanSkipNaN calculates the average value of the non-NaN elements of a series.
+	// Returns NaN if there is no non-NaN element.
+	MeanSkipNaN() float64
 	// Median calculates the middle or median value, as opposed to
-	// mean, and there is less susceptible to being affected by outliers.
+	// mean, and there is less susceptible to being affected by outliers. NaN for a Complex series.
 	Median() float64
-	// Max return the biggest element in the series
+	// Max return the biggest element in the series, NaN for a Complex series
 	Max() float64
-	// MaxStr return the biggest element in a series of type String
+	// MaxStr return the biggest element in a series of type String or Categorical
 	MaxStr() string
-	// Min return the lowest element in the series
+	// Min return the lowest element in the series, NaN for a Complex series
 	Min() float64
-	// MinStr return the lowest element in a series of type String
+	// MinStr return the lowest element in a series of type String or Categorical
 	MinStr() string
+	// ArgMax returns the index of the first biggest non-NaN element in the series,
+	// or -1 if the series is empty, Complex or all elements are NaN.
+	ArgMax() int
+	// ArgMin returns the index of the first lowest non-NaN element in the series,
+	// or -1 if the series is empty, Complex or all elements are NaN.
+	ArgMin() int
+	// NLargest returns the n biggest non-NaN elements of the series sorted in descending order.
+	// All the non-NaN elements are returned if n is larger than their number.
+	// A Complex series returns an error as its elements are not ordered.
+	NLargest(n int) Series
+	// NSmallest returns the n lowest non-NaN elements of the series sorted in ascending order.
+	// All the non-NaN elements are returned if n is larger than their number.
+	// A Complex series returns an error as its elements are not ordered.
+	NSmallest(n int) Series
 	// Quantile returns the sample of x such that x is greater than or
 	// equal to the fraction p of samples.
-	// Note: gonum/stat panics when called with strings
+	// Note: gonum/stat panics when called with strings, NaN is returned for String and Complex series.
 	Quantile(p float64) float64
+	// WeightedQuantile is like Quantile but each element is weighted by the weight at the same index.
+	// NaN elements are skipped. Returns NaN for a String or Complex series, if len(weights) != Len(), any weight is negative or p is not in [0, 1].
+	WeightedQuantile(p float64, weights []float64) float64
+	// QuantileWith is like Quantile but computed with the estimation method kind, skipping NaN.
+	// Returns NaN for a String or Complex series, if p is not in [0, 1] or kind is unknown.
+	QuantileWith(p float64, kind QuantileKind) float64
 	Quantiles(ps ...float64) []float64
 	// DataQuantile returns the data quantile in the series
 	DataQuantile(data float64) float64
 	DataQuantiles(datas ...float64) []float64
+	// Winsorize clips the values of the series to the lowerP and upperP empirical
+	// quantiles computed from the non-NaN data and returning a new Series object.
+	// Series of a type other than Int, Int64 and Float return an error.
+	Winsorize(lowerP, upperP float64) Series
+	// ClipSeries clips each value of the series to its per-row bounds [lower[i], upper[i]] and returning
+	// a new Series object. A bound of length 1 is broadcast to all the elements, NaN bounds are ignored.
+	// The bounds of Int and Int64 series are rounded inward, an element is NaN when no integer lies within its bounds.
+	// Series of a type other than Int, Int64 and Float return an error.
+	ClipSeries(lower, upper Series) Series
+	// Resample partitions the series into consecutive chunks of bucket elements and applies agg
+	// to each of them, returning a Float Series. The final partial chunk is aggregated too.
+	Resample(bucket int, agg func(Series) float64) Series
+	// ZScore standardizes the values of the series as (x - mean) / std, skipping NaN, and returns a Float Series.
+	// All the non-NaN values are 0 if the standard deviation is 0 or undefined.
+	ZScore() Series
+	// MinMaxScale rescales the values of the series as (x - min) / (max - min), skipping NaN, and returns a Float Series.
+	// All the non-NaN values are 0 if the range is 0.
+	MinMaxScale() Series
 	// Map applies a function matching MapFunction signature, which itself
 	// allowing for a fairly flexible MAP implementation, intended for mapping
 	// the function over each element in Series and returning a new Series object.
@@ -127,8 +248,23 @@ type Series interface {
 	// the function passed in via argument `f` will not expect another type, but
 	// instead expects to handle Element(s) of type Float.
 	Map(f MapFunction) Series
+	// MapParallel is like Map but the elements are split across workers goroutines,
+	// f must be safe for concurrent use. GOMAXPROCS workers are used if workers < 1.
+	MapParallel(f MapFunction, workers int) Series
+	// TryMap is like Map but f can fail, the first error stops the mapping
+	// and is returned as the error of an empty Series.
+	TryMap(f func(ele Element, index int) (Element, error)) Series
+	// Reduce folds the elements of the series into a single value, calling f with the
+	// accumulated value, starting from init, and each element in order.
+	Reduce(init interface{}, f func(acc interface{}, ele Element, index int) interface{}) interface{}
 	//Shift series by desired number of periods and returning a new Series object.
 	Shift(periods int) Series
+	// ShiftFill shifts series by desired number of periods like Shift, but pads
+	// with fill converted to the type of the series instead of NaN.
+	ShiftFill(periods int, fill ElementValue) Series
+	// Lags returns one series shifted by each of periods, positive periods lag and negative ones lead.
+	// The k-th lagged series is named name_lag(k).
+	Lags(periods ...int) []Series
 	// CumProd finds the cumulative product of the first i elements in s and returning a new Series object.
 	CumProd() Series
 	// Prod returns the product of the elements of the Series. Returns 1 if len(s) = 0.
@@ -139,13 +275,82 @@ type Series interface {
 	MulConst(c float64) Series
 	// DivConst Div the scalar c to all of the values in Series and returning a new Series object.
 	DivConst(c float64) Series
+	// Add, Sub, Mul and Div operate elementwise on the series and c returning a Float Series,
+	// or a Complex Series if either of them is Complex. A c of length 1 is broadcast to all the elements.
 	Add(c Series) Series
 	Sub(c Series) Series
 	Mul(c Series) Series
 	Div(c Series) Series
+	// Abs returns the absolute values of the series and returning a new Series object.
+	// The values of an Int series are computed in integer space, the lowest int has no absolute
+	// value and is NaN. A Complex series returns a Float Series of the magnitudes.
 	Abs() Series
+	// Round rounds the values of the series to the given number of decimals and returning a new Series object.
+	// Rounding a series of type Int, Int64 or Bool returns a copy.
+	Round(decimals int) Series
+	// Floor returns the greatest integer value less than or equal to the values of the series and returning a new Series object.
+	Floor() Series
+	// Ceil returns the least integer value greater than or equal to the values of the series and returning a new Series object.
+	Ceil() Series
+	// Trunc returns the integer part of the values of the series and returning a new Series object.
+	Trunc() Series
+	// Log returns the natural logarithm of the values of the series as a Float Series.
+	// Negative values yield NaN.
+	Log() Series
+	// Log10 returns the decimal logarithm of the values of the series as a Float Series.
+	// Negative values yield NaN.
+	Log10() Series
+	// Exp returns e**x of the values of the series as a Float Series.
+	Exp() Series
+	// Sqrt returns the square root of the values of the series as a Float Series.
+	// Negative values yield NaN.
+	Sqrt() Series
+	// Pow returns x**p of the values of the series as a Float Series.
+	Pow(p float64) Series
+	// PowSeries returns x[i]**other[i] of the values of the series as a Float Series,
+	// an other of length 1 is broadcast to all the elements.
+	PowSeries(other Series) Series
+	// Returns returns the relative change (x[i] - x[i-1]) / x[i-1] of the values of the series
+	// as a Float Series, the first element is NaN.
+	Returns() Series
+	// LogReturns returns log(x[i] / x[i-1]) of the values of the series as a Float Series,
+	// the first element is NaN. Zero or negative values yield NaN.
+	LogReturns() Series
+	// OneHot returns one Bool Series per distinct non-NaN value of the series, true where the
+	// element has that value, and the values as labels. The labels are the values formatted
+	// like Records in the order of Order, each Series is named name_label. An extra NaN column
+	// flagging the NaN elements is appended when withNaN is true.
+	OneHot(withNaN bool) ([]Series, []string)
+	// LabelEncode returns an Int Series of the codes of the values of the series and the mapping
+	// from the values formatted like Records to their codes. The codes are assigned in the order
+	// of Order, like the labels of OneHot, and the NaN elements are encoded as -1.
+	LabelEncode() (Series, map[string]int)
+	// Cut assigns the values of the series to the bins defined by the increasing edges.
+	// The bins are right-closed, (edges[i], edges[i+1]], and the first one also includes edges[0].
+	// It returns a String Series of labels, or an Int Series of bin indexes when labels is nil.
+	// Values outside the edges are NaN. Series of a type other than Int, Int64 and Float return an error.
+	Cut(edges []float64, labels []string) Series
+	// ToUpper returns a new Series of the same type with all the letters mapped to their upper case.
+	ToUpper() Series
+	// ToLower returns a new Series of the same type with all the letters mapped to their lower case.
+	ToLower() Series
+	// TrimSpace returns a new Series of the same type with all the leading and trailing white space removed.
+	TrimSpace() Series
+	// StrContains returns a Bool Series identifying which elements contain sub, NaN elements are false.
+	StrContains(sub string) Series
+	// StrHasPrefix returns a Bool Series identifying which elements begin with prefix, NaN elements are false.
+	StrHasPrefix(prefix string) Series
+	// StrHasSuffix returns a Bool Series identifying which elements end with suffix, NaN elements are false.
+	StrHasSuffix(suffix string) Series
+	// StrSplit splits the elements of a String or Categorical series by sep and returns a String Series per field.
+	// The number of fields is determined by the first non-NaN element, the elements with fewer fields
+	// are padded with NaN and the last field of the elements with more fields holds the unsplit remainder.
+	StrSplit(sep string) []Series
 	// Sum calculates the sum value of a series
 	Sum() float64
+	// SumSkipNaN calculates the sum value of the non-NaN elements of a series.
+	// Returns NaN if there is no non-NaN element.
+	SumSkipNaN() float64
 	// Empty returns an empty Series of the same type
 
 	Empty() Series
@@ -153,13 +358,28 @@ type Series interface {
 	Error() error
 	// Subset returns a subset of the series based on the given Indexes.
 	Subset(indexes Indexes) Series
+	// Reverse returns the elements of the series in reverse order.
+	Reverse() Series
+	// Sample returns n randomly chosen elements of the series, with or without replacement.
+	// The result is deterministic for a given seed.
+	Sample(n int, replace bool, seed int64) Series
+	// Shuffle returns a randomly permuted copy of the series. The permutation is deterministic
+	// for a given seed and length, so aligned series can be shuffled the same way.
+	Shuffle(seed int64) Series
 	// Concat concatenates two series together. It will return a new Series with the
 	// combined elements of both Series.
 	Concat(x Series) Series
 	// Copy will return a copy of the Series.
 	Copy() Series
+	// As converts the series to the type t keeping its name. An error is set if
+	// a non-NaN element can not be converted, e.g. a non-numeric String to Int,
+	// or a Float element with a fractional part or out of range to Int or Int64.
+	As(t Type) Series
 	// Records returns the elements of a Series as a []string
 	Records() []string
+	// RecordsFormat is like Records but the elements of a Float Series are formatted
+	// by fmt.Sprintf with floatFmt, e.g. "%.4f". The format is ignored by other types.
+	RecordsFormat(floatFmt string) []string
 	// Type returns the type of a given series
 	Type() Type
 	// Len returns the length of a given Series
@@ -177,14 +397,54 @@ type Series interface {
 	Elem(i int) Element
 	// Slice slices Series from start to end-1 index.
 	Slice(start, end int) Series
+	// SliceStep returns every step-th element from start to end-1 index, or from start down to
+	// end+1 index for a negative step, so SliceStep(Len()-1, -1, -1) reverses the series.
+	// Unlike Slice, the elements are copied.
+	SliceStep(start, end, step int) Series
+	// Head returns the first n elements of the Series, n is clamped to [0, Len()].
+	// Like Slice, the returned Series shares the elements with the Series.
+	Head(n int) Series
+	// Tail returns the last n elements of the Series, n is clamped to [0, Len()].
+	// Like Slice, the returned Series shares the elements with the Series.
+	Tail(n int) Series
+	// DropNaN returns a new Series containing only the non-NaN elements of the series.
+	DropNaN() Series
+	// TrimNaN returns a new Series without the leading and trailing NaN elements of the series,
+	// the NaN elements between the first and the last non-NaN element are kept.
+	TrimNaN() Series
+	// Coalesce returns a new Series where each NaN element is filled from the first non-NaN element
+	// at the same index among others, in order. All the series must have the same length.
+	Coalesce(others ...Series) Series
 	// FillNaN Fill NaN values using the specified value.
 	FillNaN(value ElementValue)
 	// FillNaNForward Fill NaN values using the last non-NaN value
 	FillNaNForward()
 	// FillNaNBackward fill NaN values using the next non-NaN value
 	FillNaNBackward()
+	// FillNaNForwardLimit fill NaN values using the last non-NaN value,
+	// at most limit consecutive NaN values are filled.
+	FillNaNForwardLimit(limit int)
+	// FillNaNBackwardLimit fill NaN values using the next non-NaN value,
+	// at most limit consecutive NaN values are filled.
+	FillNaNBackwardLimit(limit int)
+	// Interpolate fills NaN values by linear interpolation between the surrounding non-NaN values.
+	// The leading and trailing NaN values are linearly extrapolated when extrapolate is true,
+	// otherwise they are left as NaN.
+	Interpolate(extrapolate bool)
+	// Replace replaces the elements equal to old by new, the Series is modified in place.
+	// NaN elements are replaced when old is nil, NaN, a NaN float or a NaN Element.
+	Replace(old, new ElementValue)
+	// ReplaceMap replaces the elements equal to a key of m by the corresponding value,
+	// the Series is modified in place.
+	ReplaceMap(m map[interface{}]interface{})
 	// CacheAble returns a cacheable series and the returned series's calculation will be cached in case of repeate calculation.
 	CacheAble() Series
+	// CacheAbleWithCapacity is like CacheAble but each cache holds at most maxEntries results,
+	// the least recently used one is evicted when the cache is full. It panics if maxEntries < 1.
+	CacheAbleWithCapacity(maxEntries int) Series
+	// ResetCache clears the cached results of a cacheable series, it does nothing for other series.
+	// The mutating methods of a cacheable series reset its cache automatically.
+	ResetCache()
 	// Immutable returns an immutable series and the series can not be modified.
 	Immutable() Series
 	// Set sets the values on the indexes of a Series and returns the reference
@@ -193,23 +453,73 @@ type Series interface {
 	// Append adds new elements to the end of the Series. When using Append, the
 	// Series is modified in place.
 	Append(values interface{})
+	// AppendElement adds one element converted to the type of the Series to its end,
+	// the Series is modified in place. It is cheaper than Append for a single element.
+	AppendElement(e Element)
 	Name() string
 	SetName(name string)
 	SetErr(err error)
-	//And logical operation
+	//And logical operation returning a Bool Series whatever the type of the series. The numbers are
+	// true when they are not zero, the other elements are converted by Element.Bool and are false
+	// where it fails. NaN elements are false. in is a Series or is converted to one, strings like
+	// "true" or "1" are accepted.
 	And(in interface{}) Series
-	//Or logical operation
+	//Or logical operation, the elements are converted like And.
 	Or(in interface{}) Series
-	//Not logical operation
+	// Xor logical operation, the elements are converted like And.
+	Xor(in interface{}) Series
+	//Not logical operation returning a Bool Series, the elements are converted like And.
 	Not() Series
+	// Any returns whether any element of the series is true. NaN elements are ignored.
+	Any() bool
+	// All returns whether all elements of the series are true. NaN elements are ignored,
+	// so All returns true for an empty or all-NaN series.
+	All() bool
+	// CrossAbove returns a Bool Series which is true at index i where s[i] > other[i] and s[i-1] <= other[i-1].
+	// The first element is false, and so are the comparisons involving NaN. An other of length 1 is broadcast.
+	CrossAbove(other Series) Series
+	// CrossBelow returns a Bool Series which is true at index i where s[i] < other[i] and s[i-1] >= other[i-1].
+	// The first element is false, and so are the comparisons involving NaN. An other of length 1 is broadcast.
+	CrossBelow(other Series) Series
+	// Equal returns whether the series has the same type, length and elements as other.
+	// Elements are compared with Element.Eq, two NaN elements are considered equal.
+	Equal(other Series) bool
+	// EqualApprox is like Equal but numeric elements are considered equal
+	// when their absolute difference is not greater than tol.
+	EqualApprox(other Series, tol float64) bool
+	// Fingerprint returns a hash of the type and the elements of the series, the name is not hashed.
+	// Series which are Equal have the same fingerprint.
+	Fingerprint() uint64
 
 	//Wrap define special operations for multiple Series
 	Wrap(ss ...Series) Wrapper
 	//When define conditional computation
 	When(whenF WhenFilterFunction) When
+	// Where keeps the elements where cond is true and replaces the others by other,
+	// returning a new Series object. cond must be a Bool Series of the same length, NaN in cond is false.
+	Where(cond Series, other ElementValue) Series
+	// Mask replaces the elements where cond is true by other and keeps the others,
+	// returning a new Series object. cond must be a Bool Series of the same length, NaN in cond is false.
+	Mask(cond Series, other ElementValue) Series
 
 	//Filter Select the elements that match the FilterFunction
 	Filter(ff FilterFunction) Series
+	// GroupBy partitions the series by the values of the aligned keys, the Series of each group
+	// keeps the order of its elements and is mapped to the value of its key. NaN keys are skipped.
+	// It returns nil if keys has a different length or either series has errors.
+	GroupBy(keys Series) map[interface{}]Series
+	// GroupByAgg groups the series like GroupBy and applies agg to each group, it returns the distinct
+	// keys ordered by first appearance and the Float Series of the aggregated value of each group.
+	GroupByAgg(keys Series, agg func(Series) float64) (Series, Series)
+	// ForEach calls f with each element of the series in order and stops as soon as f returns false.
+	// The elements are not copied, so modifying them modifies the series.
+	ForEach(f func(i int, e Element) bool)
+	// Find returns the index of the first element matching the FilterFunction, or -1 if there is none.
+	Find(ff FilterFunction) int
+	// IndexOf returns the index of the first element equal to value converted to the type of the series,
+	// or -1 if there is none. NaN elements never match, and neither do the non-integral values on an
+	// Int or Int64 series.
+	IndexOf(value interface{}) int
 
 	// All the operations on Self will influence the Series's content.
 	Self() Self
@@ -234,7 +544,11 @@ func (e intElements) Append(elements Elements) Elements {
 	return ret
 }
 func (e intElements) AppendOne(element Element) Elements {
-	ele := element.(*intElement)
+	ele, ok := element.(*intElement)
+	if !ok {
+		ele = &intElement{}
+		ele.SetElement(element)
+	}
 	ret := append(e, *ele)
 	return ret
 }
@@ -264,7 +578,11 @@ func (e stringElements) Append(elements Elements) Elements {
 	return ret
 }
 func (e stringElements) AppendOne(element Element) Elements {
-	ele := element.(*stringElement)
+	ele, ok := element.(*stringElement)
+	if !ok {
+		ele = &stringElement{}
+		ele.SetElement(element)
+	}
 	ret := append(e, *ele)
 	return ret
 }
@@ -293,7 +611,11 @@ func (e floatElements) Append(elements Elements) Elements {
 	return ret
 }
 func (e floatElements) AppendOne(element Element) Elements {
-	ele := element.(*floatElement)
+	ele, ok := element.(*floatElement)
+	if !ok {
+		ele = &floatElement{}
+		ele.SetElement(element)
+	}
 	ret := append(e, *ele)
 	return ret
 }
@@ -322,7 +644,11 @@ func (e boolElements) Append(elements Elements) Elements {
 	return ret
 }
 func (e boolElements) AppendOne(element Element) Elements {
-	ele := element.(*boolElement)
+	ele, ok := element.(*boolElement)
+	if !ok {
+		ele = &boolElement{}
+		ele.SetElement(element)
+	}
 	ret := append(e, *ele)
 	return ret
 }
@@ -332,6 +658,172 @@ func (e boolElements) Copy() Elements {
 	return elements
 }
 
+// dateTimeElements is the concrete implementation of Elements for DateTime elements.
+type dateTimeElements []dateTimeElement
+
+func (e dateTimeElements) Len() int                      { return len(e) }
+func (e dateTimeElements) Elem(i int) Element            { return &e[i] }
+func (e dateTimeElements) Slice(start, end int) Elements { return e[start:end] }
+func (e dateTimeElements) Get(indexs ...int) Elements {
+	elements := make(dateTimeElements, len(indexs))
+	for k, i := range indexs {
+		elements[k] = e[i]
+	}
+	return elements
+}
+func (e dateTimeElements) Append(elements Elements) Elements {
+	eles := elements.(dateTimeElements)
+	ret := append(e, eles...)
+	return ret
+}
+func (e dateTimeElements) AppendOne(element Element) Elements {
+	ele, ok := element.(*dateTimeElement)
+	if !ok {
+		ele = &dateTimeElement{}
+		ele.SetElement(element)
+	}
+	ret := append(e, *ele)
+	return ret
+}
+func (e dateTimeElements) Copy() Elements {
+	elements := make(dateTimeElements, len(e))
+	copy(elements, e)
+	return elements
+}
+
+// int64Elements is the concrete implementation of Elements for Int64 elements.
+type int64Elements []int64Element
+
+func (e int64Elements) Len() int                      { return len(e) }
+func (e int64Elements) Elem(i int) Element            { return &e[i] }
+func (e int64Elements) Slice(start, end int) Elements { return e[start:end] }
+func (e int64Elements) Get(indexs ...int) Elements {
+	elements := make(int64Elements, len(indexs))
+	for k, i := range indexs {
+		elements[k] = e[i]
+	}
+	return elements
+}
+func (e int64Elements) Append(elements Elements) Elements {
+	eles := elements.(int64Elements)
+	ret := append(e, eles...)
+	return ret
+}
+func (e int64Elements) AppendOne(element Element) Elements {
+	ele, ok := element.(*int64Element)
+	if !ok {
+		ele = &int64Element{}
+		ele.SetElement(element)
+	}
+	ret := append(e, *ele)
+	return ret
+}
+func (e int64Elements) Copy() Elements {
+	elements := make(int64Elements, len(e))
+	copy(elements, e)
+	return elements
+}
+
+// complexElements is the concrete implementation of Elements for Complex elements.
+type complexElements []complexElement
+
+func (e complexElements) Len() int                      { return len(e) }
+func (e complexElements) Elem(i int) Element            { return &e[i] }
+func (e complexElements) Slice(start, end int) Elements { return e[start:end] }
+func (e complexElements) Get(indexs ...int) Elements {
+	elements := make(complexElements, len(indexs))
+	for k, i := range indexs {
+		elements[k] = e[i]
+	}
+	return elements
+}
+func (e complexElements) Append(elements Elements) Elements {
+	eles := elements.(complexElements)
+	ret := append(e, eles...)
+	return ret
+}
+func (e complexElements) AppendOne(element Element) Elements {
+	ele, ok := element.(*complexElement)
+	if !ok {
+		ele = &complexElement{}
+		ele.SetElement(element)
+	}
+	ret := append(e, *ele)
+	return ret
+}
+func (e complexElements) Copy() Elements {
+	elements := make(complexElements, len(e))
+	copy(elements, e)
+	return elements
+}
+
+// categoricalElements is the concrete implementation of Elements for Categorical elements,
+// the elements are stored as codes in the dictionary dict.
+type categoricalElements struct {
+	codes []int32
+	dict  *categories
+}
+
+func newCategoricalElements(n int, dict *categories) categoricalElements {
+	codes := make([]int32, n)
+	for i := range codes {
+		codes[i] = codeNaN
+	}
+	return categoricalElements{codes, dict}
+}
+
+func (e categoricalElements) Len() int { return len(e.codes) }
+func (e categoricalElements) Elem(i int) Element {
+	return &categoricalElement{e.dict, &e.codes[i]}
+}
+func (e categoricalElements) Slice(start, end int) Elements {
+	return categoricalElements{e.codes[start:end], e.dict}
+}
+func (e categoricalElements) Get(indexs ...int) Elements {
+	codes := make([]int32, len(indexs))
+	for k, i := range indexs {
+		codes[k] = e.codes[i]
+	}
+	return categoricalElements{codes, e.dict.clone()}
+}
+
+// Append re-encodes the elements with the dictionary of e unless they share it.
+func (e categoricalElements) Append(elements Elements) Elements {
+	eles := elements.(categoricalElements)
+	if eles.dict == e.dict {
+		return categoricalElements{append(e.codes, eles.codes...), e.dict}
+	}
+	ret := e.codes
+	for i := range eles.codes {
+		ret = append(ret, codeNaN)
+		ele := categoricalElement{e.dict, &ret[len(ret)-1]}
+		ele.SetElement(eles.Elem(i))
+	}
+	return categoricalElements{ret, e.dict}
+}
+func (e categoricalElements) AppendOne(element Element) Elements {
+	ret := append(e.codes, codeNaN)
+	ele := categoricalElement{e.dict, &ret[len(ret)-1]}
+	ele.SetElement(element)
+	return categoricalElements{ret, e.dict}
+}
+
+// Copy clones the dictionary so setting new categories on the copy leaves e unchanged.
+func (e categoricalElements) Copy() Elements {
+	codes := make([]int32, len(e.codes))
+	copy(codes, e.codes)
+	return categoricalElements{codes, e.dict.clone()}
+}
+
+// String formats the elements like the slices of the other Elements.
+func (e categoricalElements) String() string {
+	elements := make([]string, len(e.codes))
+	for i := range e.codes {
+		elements[i] = e.Elem(i).String()
+	}
+	return fmt.Sprint(elements)
+}
+
 // ElementValue represents the value that can be used for marshaling or
 // unmarshaling Elements.
 type ElementValue interface{}
@@ -344,14 +836,16 @@ type Comparator string
 
 // Supported Comparators
 const (
-	Eq        Comparator = "=="   // Equal
-	Neq       Comparator = "!="   // Non equal
-	Greater   Comparator = ">"    // Greater than
-	GreaterEq Comparator = ">="   // Greater or equal than
-	Less      Comparator = "<"    // Lesser than
-	LessEq    Comparator = "<="   // Lesser or equal than
-	In        Comparator = "in"   // Inside
-	CompFunc  Comparator = "func" // user-defined comparison function
+	Eq        Comparator = "=="     // Equal
+	Neq       Comparator = "!="     // Non equal
+	Greater   Comparator = ">"      // Greater than
+	GreaterEq Comparator = ">="     // Greater or equal than
+	Less      Comparator = "<"      // Lesser than
+	LessEq    Comparator = "<="     // Lesser or equal than
+	In        Comparator = "in"     // Inside
+	NotIn     Comparator = "notin"  // Not inside, NaN elements are not inside
+	CompFunc  Comparator = "func"   // user-defined comparison function
+	Regexp    Comparator = "regexp" // Matches the regular expression
 )
 
 // compFunc defines a user-defined comparator function. Used internally for type assertions
@@ -363,10 +857,14 @@ type Type string
 
 // Supported Series Types
 const (
-	String Type = "string"
-	Int    Type = "int"
-	Float  Type = "float"
-	Bool   Type = "bool"
+	String      Type = "string"
+	Int         Type = "int"
+	Float       Type = "float"
+	Bool        Type = "bool"
+	DateTime    Type = "datetime"
+	Int64       Type = "int64"
+	Complex     Type = "complex"
+	Categorical Type = "categorical"
 )
 
 func (t Type) emptyElements(n int) Elements {
@@ -380,12 +878,25 @@ func (t Type) emptyElements(n int) Elements {
 		elements = make(floatElements, n)
 	case Bool:
 		elements = make(boolElements, n)
+	case DateTime:
+		elements = make(dateTimeElements, n)
+	case Int64:
+		elements = make(int64Elements, n)
+	case Complex:
+		elements = make(complexElements, n)
+	case Categorical:
+		elements = newCategoricalElements(n, newCategories())
 	default:
 		panic(fmt.Sprintf("unknown type %v", t))
 	}
 	return elements
 }
 
+// numeric returns whether the elements of type t are real numbers.
+func (t Type) numeric() bool {
+	return t == Int || t == Int64 || t == Float
+}
+
 const NaN = "NaN"
 
 // Indexes represent the elements that can be used for selecting a subset of
@@ -422,7 +933,7 @@ func newSeries(values interface{}, t Type, name string) series {
 
 	if values == nil {
 		preAlloc(1)
-		ret.elements.Elem(0).Set(nil)
+		ret.elements.Elem(0).SetNA()
 		return ret
 	}
 
@@ -484,6 +995,37 @@ func newSeries(values interface{}, t Type, name string) series {
 	return ret
 }
 
+// NewStrict is like New but returns an error listing the indexes of the values which
+// could not be converted to the type t, instead of silently setting them to NaN.
+// nil, "NaN", a NaN float and a NaN Element are valid NaN values.
+func NewStrict(values interface{}, t Type, name string) (Series, error) {
+	ret := newSeries(values, t, name)
+	var failed []int
+	for i := 0; i < ret.Len(); i++ {
+		if ret.elements.Elem(i).IsNA() && !isNaNValue(valueAt(values, i)) {
+			failed = append(failed, i)
+		}
+	}
+	if len(failed) > 0 {
+		return nil, fmt.Errorf("parse error: can't convert the values at indexes %v to %v", failed, t)
+	}
+	return &ret, nil
+}
+
+// valueAt returns the i-th value the way newSeries reads it.
+func valueAt(values interface{}, i int) interface{} {
+	switch v := values.(type) {
+	case nil:
+		return nil
+	case Series:
+		return v.Elem(i)
+	}
+	if reflect.TypeOf(values).Kind() == reflect.Slice {
+		return reflect.ValueOf(values).Index(i).Interface()
+	}
+	return values
+}
+
 func NewDefault(defaultValue interface{}, t Type, name string, len int) Series {
 	ret := &series{
 		name: name,
@@ -497,7 +1039,7 @@ func NewDefault(defaultValue interface{}, t Type, name string, len int) Series {
 
 	if defaultValue == nil {
 		preAlloc(1)
-		ret.elements.Elem(0).Set(nil)
+		ret.elements.Elem(0).SetNA()
 		return ret
 	}
 	preAlloc(len)
@@ -551,6 +1093,28 @@ func Bools(values interface{}) Series {
 	return New(values, Bool, "")
 }
 
+// Times is a constructor for a DateTime Series
+func Times(values []time.Time) Series {
+	return New(values, DateTime, "")
+}
+
+// Int64s is a constructor for an Int64 Series, the values are compared and
+// ordered in int64 space so large integers keep their precision.
+func Int64s(values interface{}) Series {
+	return New(values, Int64, "")
+}
+
+// Categoricals is a constructor for a Categorical Series, it behaves like a String
+// Series but stores each distinct value once and the elements as dictionary codes.
+func Categoricals(values interface{}) Series {
+	return New(values, Categorical, "")
+}
+
+// Complexes is a constructor for a Complex Series
+func Complexes(values interface{}) Series {
+	return New(values, Complex, "")
+}
+
 // Empty returns an empty Series of the same type
 func (s series) Empty() Series {
 	return New([]int{}, s.t, s.name)
@@ -575,6 +1139,15 @@ func (s *series) Append(values interface{}) {
 	s.elements = s.elements.Append(news.elements)
 }
 
+// AppendElement adds one element converted to the type of the Series to its end,
+// the Series is modified in place. It is cheaper than Append for a single element.
+func (s *series) AppendElement(e Element) {
+	if err := s.err; err != nil {
+		return
+	}
+	s.elements = s.elements.AppendOne(e)
+}
+
 // Concat concatenates two series together. It will return a new Series with the
 // combined elements of both Series.
 func (s series) Concat(x Series) Series {
@@ -590,6 +1163,42 @@ func (s series) Concat(x Series) Series {
 	return y
 }
 
+// Concat concatenates the series together with a single allocation. It will return a
+// new Series with the type and the name of the first one, the other series are
+// converted to that type. The first error of the series is propagated.
+func Concat(ss ...Series) Series {
+	if len(ss) == 0 {
+		return Err(fmt.Errorf("concat error: no series"))
+	}
+	first := ss[0]
+	if err := first.Error(); err != nil {
+		return first
+	}
+	t := first.Type()
+	n := 0
+	for _, x := range ss {
+		if err := x.Error(); err != nil {
+			ret := New([]int{}, t, first.Name())
+			ret.SetErr(fmt.Errorf("concat error: argument has errors: %v", err))
+			return ret
+		}
+		n += x.Len()
+	}
+	elements := t.emptyElements(n)
+	i := 0
+	for _, x := range ss {
+		for j := 0; j < x.Len(); j++ {
+			elements.Elem(i).SetElement(x.Elem(j))
+			i++
+		}
+	}
+	return &series{
+		name:     first.Name(),
+		elements: elements,
+		t:        t,
+	}
+}
+
 // Subset returns a subset of the series based on the given Indexes.
 func (s series) Subset(indexes Indexes) Series {
 	if err := s.err; err != nil {
@@ -608,6 +1217,15 @@ func (s series) Subset(indexes Indexes) Series {
 	return ret
 }
 
+// Reverse returns the elements of the series in reverse order.
+func (s series) Reverse() Series {
+	idx := make([]int, s.Len())
+	for i := range idx {
+		idx[i] = s.Len() - 1 - i
+	}
+	return s.Subset(idx)
+}
+
 // Set sets the values on the indexes of a Series and returns the reference
 // for itself. The original Series is modified.
 func (s *series) Set(indexes Indexes, newvalues Series) Series {
@@ -665,6 +1283,26 @@ func (s series) IsNotNaN() []bool {
 	return ret
 }
 
+// FirstValidIndex returns the index of the first non-NaN element, or -1 if there is none.
+func (s series) FirstValidIndex() int {
+	for i := 0; i < s.Len(); i++ {
+		if !s.elements.Elem(i).IsNA() {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastValidIndex returns the index of the last non-NaN element, or -1 if there is none.
+func (s series) LastValidIndex() int {
+	for i := s.Len() - 1; i >= 0; i-- {
+		if !s.elements.Elem(i).IsNA() {
+			return i
+		}
+	}
+	return -1
+}
+
 // Compare compares the values of a Series with other elements. To do so, the
 // elements with are to be compared are first transformed to a Series of the same
 // type as the caller.
@@ -710,26 +1348,44 @@ func (s series) Compare(comparator Comparator, comparando interface{}) Series {
 		return Bools(bools)
 	}
 
+	// Regexp comparator comparison
+	if comparator == Regexp {
+		var re *regexp.Regexp
+		switch v := comparando.(type) {
+		case *regexp.Regexp:
+			re = v
+		case string:
+			var err error
+			re, err = regexp.Compile(v)
+			if err != nil {
+				s1 := s.Empty()
+				s1.SetErr(fmt.Errorf("can't compare: %v", err))
+				return s1
+			}
+		default:
+			s1 := s.Empty()
+			s1.SetErr(fmt.Errorf("can't compare: comparando is not a string or *regexp.Regexp"))
+			return s1
+		}
+
+		for i := 0; i < s.Len(); i++ {
+			e := s.elements.Elem(i)
+			bools[i] = !e.IsNA() && re.MatchString(e.String())
+		}
+		return Bools(bools)
+	}
+
 	comp := newSeries(comparando, s.t, "")
-	// In comparator comparison
-	if comparator == In {
+	// In and NotIn comparator comparison
+	if comparator == In || comparator == NotIn {
+		members := comp.valueSet()
 		for i := 0; i < s.Len(); i++ {
 			e := s.elements.Elem(i)
 			b := false
-			for j := 0; j < comp.Len(); j++ {
-				m := comp.elements.Elem(j)
-				c, err := compareElements(e, m, Eq)
-				if err != nil {
-					s1 := s.Empty()
-					s1.SetErr(err)
-					return s1
-				}
-				if c {
-					b = true
-					break
-				}
+			if !e.IsNA() {
+				_, b = members[e.Val()]
 			}
-			bools[i] = b
+			bools[i] = b == (comparator == In)
 		}
 		return Bools(bools)
 	}
@@ -768,6 +1424,49 @@ func (s series) Compare(comparator Comparator, comparando interface{}) Series {
 	return Bools(bools)
 }
 
+// IsIn returns a Bool Series that identifies which of the elements are in values,
+// it is a shortcut for Compare(In, values).
+func (s series) IsIn(values interface{}) Series {
+	return s.Compare(In, values)
+}
+
+// IsInSet returns a Bool Series that identifies which of the elements are in set,
+// only the keys mapped to true are members. The keys are first transformed to the
+// type of the caller, the membership of each element is then checked in O(1).
+func (s series) IsInSet(set map[interface{}]bool) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	keys := make([]interface{}, 0, len(set))
+	for k, member := range set {
+		if member {
+			keys = append(keys, k)
+		}
+	}
+	members := newSeries(keys, s.t, "").valueSet()
+	bools := make([]bool, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		e := s.elements.Elem(i)
+		if e.IsNA() {
+			continue
+		}
+		_, bools[i] = members[e.Val()]
+	}
+	return Bools(bools)
+}
+
+// valueSet returns the set of the values of the non-NaN elements of the series.
+func (s series) valueSet() map[interface{}]struct{} {
+	set := make(map[interface{}]struct{}, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		e := s.elements.Elem(i)
+		if !e.IsNA() {
+			set[e.Val()] = struct{}{}
+		}
+	}
+	return set
+}
+
 // Copy will return a copy of the Series.
 func (s series) Copy() Series {
 	ret := &series{
@@ -779,6 +1478,41 @@ func (s series) Copy() Series {
 	return ret
 }
 
+// As converts the series to the type t keeping its name. An error is set if
+// a non-NaN element can not be converted, e.g. a non-numeric String to Int,
+// or a Float element with a fractional part or out of range to Int or Int64.
+func (s series) As(t Type) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	switch t {
+	case String, Int, Float, Bool, DateTime, Int64, Complex, Categorical:
+	default:
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("conversion error: unknown type %v", t))
+		return empty
+	}
+
+	eles := t.emptyElements(s.Len())
+	for i := 0; i < s.Len(); i++ {
+		e := s.elements.Elem(i)
+		eles.Elem(i).SetElement(e)
+		lossy := s.t == Float && (t == Int || t == Int64) && eles.Elem(i).Float() != e.Float()
+		if !e.IsNA() && (eles.Elem(i).IsNA() || lossy) {
+			empty := New([]int{}, t, s.name)
+			empty.SetErr(fmt.Errorf("conversion error: can't convert %v \"%v\" to %v", s.t, e, t))
+			return empty
+		}
+	}
+	ret := &series{
+		name:     s.name,
+		t:        t,
+		elements: eles,
+		err:      nil,
+	}
+	return ret
+}
+
 // Records returns the elements of a Series as a []string
 func (s series) Records() []string {
 	ret := make([]string, s.Len())
@@ -789,11 +1523,40 @@ func (s series) Records() []string {
 	return ret
 }
 
+// RecordsFormat is like Records but the elements of a Float Series are formatted
+// by fmt.Sprintf with floatFmt, e.g. "%.4f". The format is ignored by other types.
+func (s series) RecordsFormat(floatFmt string) []string {
+	if s.t != Float {
+		return s.Records()
+	}
+	ret := make([]string, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		e := s.elements.Elem(i)
+		if e.IsNA() {
+			ret[i] = NaN
+			continue
+		}
+		ret[i] = fmt.Sprintf(floatFmt, e.Float())
+	}
+	return ret
+}
+
 // Float returns the elements of a Series as a []float64. If the elements can not
 // be converted to float64 or contains a NaN returns the float representation of
 // NaN.
 func (s series) Float() []float64 {
 	ret := make([]float64, s.Len())
+	if eles, ok := s.elements.(floatElements); ok {
+		// fast path avoiding the per element interface calls
+		for i, e := range eles {
+			if e.nan {
+				ret[i] = math.NaN()
+			} else {
+				ret[i] = e.e
+			}
+		}
+		return ret
+	}
 	for i := 0; i < s.Len(); i++ {
 		e := s.elements.Elem(i)
 		ret[i] = e.Float()
@@ -831,6 +1594,83 @@ func (s series) Bool() ([]bool, error) {
 	return ret, nil
 }
 
+// ToSlice returns the elements of a Series as a native slice according to its type:
+// []int, []int64, []float64, []complex128, []bool, []time.Time or []string. NaN elements
+// of Int, Int64, Bool and DateTime series are returned as the zero value, those of a
+// Complex series as NaN.
+func (s series) ToSlice() interface{} {
+	switch s.t {
+	case Int:
+		ret := make([]int, s.Len())
+		for i := 0; i < s.Len(); i++ {
+			ret[i], _ = s.elements.Elem(i).Int()
+		}
+		return ret
+	case Bool:
+		ret := make([]bool, s.Len())
+		for i := 0; i < s.Len(); i++ {
+			ret[i], _ = s.elements.Elem(i).Bool()
+		}
+		return ret
+	case Int64:
+		ret := make([]int64, s.Len())
+		for i := 0; i < s.Len(); i++ {
+			if v, ok := s.elements.Elem(i).Val().(int64); ok {
+				ret[i] = v
+			}
+		}
+		return ret
+	case Float:
+		return s.Float()
+	case Complex:
+		ret := make([]complex128, s.Len())
+		for i := 0; i < s.Len(); i++ {
+			if v, ok := s.elements.Elem(i).Val().(complex128); ok {
+				ret[i] = v
+			} else {
+				ret[i] = cmplx.NaN()
+			}
+		}
+		return ret
+	case DateTime:
+		ret := make([]time.Time, s.Len())
+		for i := 0; i < s.Len(); i++ {
+			if t, ok := s.elements.Elem(i).Val().(time.Time); ok {
+				ret[i] = t
+			}
+		}
+		return ret
+	default:
+		return s.Records()
+	}
+}
+
+// Codes returns the dictionary codes of the elements of a Categorical series, NaN elements are -1.
+// It returns nil for the other types.
+func (s series) Codes() []int {
+	elements, ok := s.elements.(categoricalElements)
+	if !ok {
+		return nil
+	}
+	codes := make([]int, len(elements.codes))
+	for i, code := range elements.codes {
+		codes[i] = int(code)
+	}
+	return codes
+}
+
+// Categories returns the dictionary of a Categorical series indexed by the codes, it may contain
+// the unused categories of the series it was copied from. It returns nil for the other types.
+func (s series) Categories() []string {
+	elements, ok := s.elements.(categoricalElements)
+	if !ok {
+		return nil
+	}
+	categories := make([]string, len(elements.dict.values))
+	copy(categories, elements.dict.values)
+	return categories
+}
+
 // Type returns the type of a given series
 func (s series) Type() Type {
 	return s.t
@@ -877,8 +1717,8 @@ func (s series) Elem(i int) Element {
 	return s.elements.Elem(i)
 }
 
-// parseIndexes will parse the given indexes for a given series of length `l`. No
-// out of bounds checks is performed.
+// parseIndexes will parse the given indexes for a given series of length `l`.
+// An error is returned if any index is out of the bounds [0, l).
 func parseIndexes(l int, indexes Indexes) ([]int, error) {
 	var idx []int
 	switch idxs := indexes.(type) {
@@ -906,7 +1746,11 @@ func parseIndexes(l int, indexes Indexes) ([]int, error) {
 		}
 		switch s.Type() {
 		case Int:
-			return s.Int()
+			ints, err := s.Int()
+			if err != nil {
+				return nil, fmt.Errorf("indexing error: %v", err)
+			}
+			idx = ints
 		case Bool:
 			bools, err := s.Bool()
 			if err != nil {
@@ -919,11 +1763,16 @@ func parseIndexes(l int, indexes Indexes) ([]int, error) {
 	default:
 		return nil, fmt.Errorf("indexing error: unknown indexing mode")
 	}
+	for _, i := range idx {
+		if i < 0 || i >= l {
+			return nil, fmt.Errorf("indexing error: index %d out of bounds for length %d", i, l)
+		}
+	}
 	return idx, nil
 }
 
 // Order returns the indexes for sorting a Series. NaN elements are pushed to the
-// end by order of appearance.
+// end by order of appearance. Complex elements are not ordered so they keep their order of appearance.
 func (s series) Order(reverse bool) []int {
 	var ie indexedElements
 	var nasIdx []int
@@ -965,6 +1814,13 @@ func (s series) StdDev() float64 {
 	return stdDev
 }
 
+// StdDevPopulation calculates the population standard deviation of a series, dividing by n
+// instead of the n-1 of StdDev, which is the default of numpy.
+func (s series) StdDevPopulation() float64 {
+	stdDev := stat.PopStdDev(s.Float(), nil)
+	return stdDev
+}
+
 // Mean calculates the average value of a series
 func (s series) Mean() float64 {
 	stdDev := stat.Mean(s.Float(), nil)
@@ -972,11 +1828,12 @@ func (s series) Mean() float64 {
 }
 
 // Median calculates the middle or median value, as opposed to
-// mean, and there is less susceptible to being affected by outliers.
+// mean, and there is less susceptible to being affected by outliers. NaN for a Complex series.
 func (s series) Median() float64 {
 	if s.elements.Len() == 0 ||
 		s.Type() == String ||
-		s.Type() == Bool {
+		s.Type() == Bool ||
+		s.Type() == Complex {
 		return math.NaN()
 	}
 	ix := s.Order(false)
@@ -997,9 +1854,9 @@ func (s series) Median() float64 {
 		newElem[len(newElem)/2].Float()) * 0.5
 }
 
-// Max return the biggest element in the series
+// Max return the biggest element in the series, NaN for a Complex series
 func (s series) Max() float64 {
-	if s.elements.Len() == 0 || s.Type() == String {
+	if s.elements.Len() == 0 || s.Type() == String || s.Type() == Complex {
 		return math.NaN()
 	}
 
@@ -1013,9 +1870,9 @@ func (s series) Max() float64 {
 	return max.Float()
 }
 
-// MaxStr return the biggest element in a series of type String
+// MaxStr return the biggest element in a series of type String or Categorical
 func (s series) MaxStr() string {
-	if s.elements.Len() == 0 || s.Type() != String {
+	if s.elements.Len() == 0 || (s.Type() != String && s.Type() != Categorical) {
 		return ""
 	}
 
@@ -1029,9 +1886,9 @@ func (s series) MaxStr() string {
 	return max.String()
 }
 
-// Min return the lowest element in the series
+// Min return the lowest element in the series, NaN for a Complex series
 func (s series) Min() float64 {
-	if s.elements.Len() == 0 || s.Type() == String {
+	if s.elements.Len() == 0 || s.Type() == String || s.Type() == Complex {
 		return math.NaN()
 	}
 
@@ -1045,9 +1902,9 @@ func (s series) Min() float64 {
 	return min.Float()
 }
 
-// MinStr return the lowest element in a series of type String
+// MinStr return the lowest element in a series of type String or Categorical
 func (s series) MinStr() string {
-	if s.elements.Len() == 0 || s.Type() != String {
+	if s.elements.Len() == 0 || (s.Type() != String && s.Type() != Categorical) {
 		return ""
 	}
 
@@ -1063,9 +1920,9 @@ func (s series) MinStr() string {
 
 // Quantile returns the sample of x such that x is greater than or
 // equal to the fraction p of samples.
-// Note: gonum/stat panics when called with strings
+// Note: gonum/stat panics when called with strings, NaN is returned for String and Complex series.
 func (s series) Quantile(p float64) float64 {
-	if s.Type() == String || s.Len() == 0 {
+	if s.Type() == String || s.Type() == Complex || s.Len() == 0 {
 		return math.NaN()
 	}
 	if p == 0 {
@@ -1081,7 +1938,7 @@ func (s series) Quantile(p float64) float64 {
 }
 
 func (s series) Quantiles(ps ...float64) []float64 {
-	if s.Type() == String || s.Len() == 0 {
+	if s.Type() == String || s.Type() == Complex || s.Len() == 0 {
 		return nil
 	}
 
@@ -1189,8 +2046,115 @@ func (s series) Map(f MapFunction) Series {
 	return ret
 }
 
+// MapParallel is like Map but the elements are split across workers goroutines,
+// f must be safe for concurrent use. GOMAXPROCS workers are used if workers < 1.
+func (s series) MapParallel(f MapFunction, workers int) Series {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	n := s.Len()
+	if workers > n {
+		workers = n
+	}
+	eles := s.Type().emptyElements(n)
+	// the elements of a Categorical series share their dictionary, they are set after the workers
+	var values []Element
+	if s.t == Categorical {
+		values = make([]Element, n)
+	}
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start, end := w*n/workers, (w+1)*n/workers
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// each worker writes a disjoint range of eles
+			for i := start; i < end; i++ {
+				value := f(s.elements.Elem(i), i)
+				if values != nil {
+					values[i] = value
+					continue
+				}
+				eles.Elem(i).SetElement(value)
+			}
+		}()
+	}
+	wg.Wait()
+	for i, value := range values {
+		eles.Elem(i).SetElement(value)
+	}
+	ret := &series{
+		name:     s.name,
+		elements: eles,
+		t:        s.Type(),
+		err:      nil,
+	}
+	return ret
+}
+
+// TryMap is like Map but f can fail, the first error stops the mapping
+// and is returned as the error of an empty Series.
+func (s series) TryMap(f func(ele Element, index int) (Element, error)) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	eles := s.Type().emptyElements(s.Len())
+	for i := 0; i < s.Len(); i++ {
+		value, err := f(s.elements.Elem(i), i)
+		if err != nil {
+			empty := s.Empty()
+			empty.SetErr(fmt.Errorf("map error at index %d: %v", i, err))
+			return empty
+		}
+		eles.Elem(i).SetElement(value)
+	}
+	ret := &series{
+		name:     s.name,
+		elements: eles,
+		t:        s.Type(),
+		err:      nil,
+	}
+	return ret
+}
+
+// Reduce folds the elements of the series into a single value, calling f with the
+// accumulated value, starting from init, and each element in order.
+func (s series) Reduce(init interface{}, f func(acc interface{}, ele Element, index int) interface{}) interface{} {
+	acc := init
+	for i := 0; i < s.Len(); i++ {
+		acc = f(acc, s.elements.Elem(i), i)
+	}
+	return acc
+}
+
 //Shift series by desired number of periods and returning a new Series object.
 func (s series) Shift(periods int) Series {
+	return s.shift(periods, fmt.Sprintf("%s_Shift(%d)", s.name, periods), func(ele Element) {
+		ele.SetNA()
+	})
+}
+
+// ShiftFill shifts series by desired number of periods like Shift, but pads
+// with fill converted to the type of the series instead of NaN.
+func (s series) ShiftFill(periods int, fill ElementValue) Series {
+	return s.shift(periods, fmt.Sprintf("%s_ShiftFill(%d)", s.name, periods), func(ele Element) {
+		ele.Set(fill)
+	})
+}
+
+// Lags returns one series shifted by each of periods, positive periods lag and negative ones lead.
+// The k-th lagged series is named name_lag(k).
+func (s series) Lags(periods ...int) []Series {
+	lags := make([]Series, len(periods))
+	for i, k := range periods {
+		lags[i] = s.Shift(k)
+		lags[i].SetName(fmt.Sprintf("%s_lag(%d)", s.name, k))
+	}
+	return lags
+}
+
+// shift moves the elements by periods and pads the vacated positions with pad.
+func (s series) shift(periods int, name string, pad func(ele Element)) Series {
 	if s.Len() == 0 {
 		return s.Empty()
 	}
@@ -1202,21 +2166,24 @@ func (s series) Shift(periods int) Series {
 	if naLen < 0 {
 		naLen = -naLen
 	}
+	if naLen > s.Len() {
+		naLen = s.Len()
+	}
 	naEles := s.t.emptyElements(naLen)
 	for i := 0; i < naLen; i++ {
-		naEles.Elem(i).Set(NaN)
+		pad(naEles.Elem(i))
 	}
 
 	var shiftElements Elements
 	if periods < 0 {
 		//shift up
-		shiftElements = s.elements.Slice(-periods, s.Len()).Copy().Append(naEles)
+		shiftElements = s.elements.Slice(naLen, s.Len()).Copy().Append(naEles)
 	} else if periods > 0 {
 		//move down
-		shiftElements = naEles.Append(s.elements.Slice(0, s.Len()-periods))
+		shiftElements = naEles.Append(s.elements.Slice(0, s.Len()-naLen))
 	}
 	ret := &series{
-		name:     fmt.Sprintf("%s_Shift(%d)", s.name, periods),
+		name:     name,
 		elements: shiftElements,
 		t:        s.t,
 		err:      nil,
@@ -1267,41 +2234,166 @@ func (s series) DivConst(c float64) Series {
 	return sm
 }
 
+// checkOperand validates the operand c of an arithmetic operation,
+// it must have the length of s or a length of 1.
+func (s series) checkOperand(c Series) error {
+	if err := s.err; err != nil {
+		return err
+	}
+	if err := c.Error(); err != nil {
+		return fmt.Errorf("argument has errors: %v", err)
+	}
+	if c.Len() != s.Len() && c.Len() != 1 {
+		return fmt.Errorf("length mismatch (%d != %d)", s.Len(), c.Len())
+	}
+	return nil
+}
+
+// operandFloats returns the float values of the operand c of an arithmetic operation,
+// a series of length 1 is broadcast to the length of s.
+func (s series) operandFloats(c Series) []float64 {
+	cf := c.Float()
+	if c.Len() == 1 && s.Len() != 1 {
+		v := cf[0]
+		cf = make([]float64, s.Len())
+		for i := range cf {
+			cf[i] = v
+		}
+	}
+	return cf
+}
+
 func (s series) Add(c Series) Series {
+	name := fmt.Sprintf("(%s + %s)", s.name, c.Name())
+	if err := s.checkOperand(c); err != nil {
+		empty := New([]float64{}, Float, name)
+		empty.SetErr(fmt.Errorf("can't add: %v", err))
+		return empty
+	}
+	if s.t == Complex || c.Type() == Complex {
+		return s.complexArith(c, name, func(x, y complex128) complex128 {
+			return x + y
+		})
+	}
 	sf := s.Float()
-	cf := c.Float()
+	cf := s.operandFloats(c)
 	dst := make([]float64, s.Len())
 	floats.AddTo(dst, sf, cf)
-	return New(dst, Float, fmt.Sprintf("(%s + %s)", s.name, c.Name()))
+	return New(dst, Float, name)
 }
 
 func (s series) Sub(c Series) Series {
+	name := fmt.Sprintf("(%s - %s)", s.name, c.Name())
+	if err := s.checkOperand(c); err != nil {
+		empty := New([]float64{}, Float, name)
+		empty.SetErr(fmt.Errorf("can't subtract: %v", err))
+		return empty
+	}
+	if s.t == Complex || c.Type() == Complex {
+		return s.complexArith(c, name, func(x, y complex128) complex128 {
+			return x - y
+		})
+	}
 	sf := s.Float()
-	cf := c.Float()
+	cf := s.operandFloats(c)
 	dst := make([]float64, s.Len())
 	floats.SubTo(dst, sf, cf)
-	return New(dst, Float, fmt.Sprintf("(%s - %s)", s.name, c.Name()))
+	return New(dst, Float, name)
 }
 
 func (s series) Mul(c Series) Series {
+	name := fmt.Sprintf("(%s * %s)", s.name, c.Name())
+	if err := s.checkOperand(c); err != nil {
+		empty := New([]float64{}, Float, name)
+		empty.SetErr(fmt.Errorf("can't multiply: %v", err))
+		return empty
+	}
+	if s.t == Complex || c.Type() == Complex {
+		return s.complexArith(c, name, func(x, y complex128) complex128 {
+			return x * y
+		})
+	}
 	sf := s.Float()
-	cf := c.Float()
+	cf := s.operandFloats(c)
 	dst := make([]float64, s.Len())
 	floats.MulTo(dst, sf, cf)
-	return New(dst, Float, fmt.Sprintf("(%s * %s)", s.name, c.Name()))
+	return New(dst, Float, name)
 }
 
 func (s series) Div(c Series) Series {
+	name := fmt.Sprintf("(%s / %s)", s.name, c.Name())
+	if err := s.checkOperand(c); err != nil {
+		empty := New([]float64{}, Float, name)
+		empty.SetErr(fmt.Errorf("can't divide: %v", err))
+		return empty
+	}
+	if s.t == Complex || c.Type() == Complex {
+		return s.complexArith(c, name, func(x, y complex128) complex128 {
+			return x / y
+		})
+	}
 	sf := s.Float()
-	cf := c.Float()
+	cf := s.operandFloats(c)
 	dst := make([]float64, s.Len())
 	floats.DivTo(dst, sf, cf)
-	return New(dst, Float, fmt.Sprintf("(%s / %s)", s.name, c.Name()))
+	return New(dst, Float, name)
+}
+
+// complexArith applies f elementwise to the complex values of the series and c and returns
+// a Complex Series, a c of length 1 is broadcast. NaN elements are kept as NaN.
+func (s series) complexArith(c Series, name string, f func(x, y complex128) complex128) Series {
+	elements := make(complexElements, s.Len())
+	var x, y complexElement
+	for i := range elements {
+		x.SetElement(s.elements.Elem(i))
+		if c.Len() == 1 {
+			y.SetElement(c.Elem(0))
+		} else {
+			y.SetElement(c.Elem(i))
+		}
+		if x.IsNA() || y.IsNA() {
+			elements[i].SetNA()
+			continue
+		}
+		elements[i].setComplex(f(x.e, y.e))
+	}
+	ret := &series{
+		name:     name,
+		elements: elements,
+		t:        Complex,
+		err:      nil,
+	}
+	return ret
 }
 
+// Abs returns the absolute values of the series and returning a new Series object.
+// The values of an Int series are computed in integer space, the lowest int has no absolute
+// value and is NaN. A Complex series returns a Float Series of the magnitudes.
 func (s series) Abs() Series {
+	if s.t == Complex {
+		elements := make(floatElements, s.Len())
+		for i := range elements {
+			ele := s.elements.Elem(i)
+			if ele.IsNA() {
+				elements[i].SetNA()
+				continue
+			}
+			elements[i].SetFloat(cmplx.Abs(ele.Val().(complex128)))
+		}
+		ret := &series{
+			name:     fmt.Sprintf("Abs(%s)", s.name),
+			elements: elements,
+			t:        Float,
+			err:      nil,
+		}
+		return ret
+	}
 	sm := s.Map(func(e Element, index int) Element {
 		result := e.Copy()
+		if s.t == Int {
+			absInt(result)
+			return result
+		}
 		f := result.Float()
 		result.Set(math.Abs(f))
 		return result
@@ -1310,6 +2402,30 @@ func (s series) Abs() Series {
 	return sm
 }
 
+// absInt sets an Int or Int64 element to its absolute value, the lowest value overflows so it is set to NaN.
+func absInt(ele Element) {
+	switch v := ele.Val().(type) {
+	case int:
+		if v >= 0 {
+			return
+		}
+		if -v < 0 {
+			ele.SetNA()
+			return
+		}
+		ele.Set(-v)
+	case int64:
+		if v >= 0 {
+			return
+		}
+		if -v < 0 {
+			ele.SetNA()
+			return
+		}
+		ele.Set(-v)
+	}
+}
+
 // FillNaN Fill NaN values using the specified value.
 func (s series) FillNaN(value ElementValue) {
 	for i := 0; i < s.Len(); i++ {
@@ -1354,20 +2470,52 @@ func (s series) Rolling(window int, minPeriods int) RollingSeries {
 	return newRollingSeries(window, minPeriods, &s)
 }
 
+// Expanding returns an expanding series, the window of the i-th element is [0, i].
+func (s series) Expanding(minPeriods int) ExpandingSeries {
+	return newExpandingSeries(minPeriods, &s)
+}
+
 // CacheAble returns a cacheable series and the returned series's calculation will be cached in case of repeate calcution.
-// You should make sure that the series will not be modified and has a unique name.
+// You should make sure that the series will not be modified. The results of the methods
+// taking another Series, like Cov, are cached by the Fingerprint of that Series.
 func (s series) CacheAble() Series {
 	return newCacheAbleSeries(&s)
 }
+// CacheAbleWithCapacity is like CacheAble but each cache holds at most maxEntries results,
+// the least recently used one is evicted when the cache is full. It panics if maxEntries < 1.
+func (s series) CacheAbleWithCapacity(maxEntries int) Series {
+	return newCacheAbleSeriesWithCapacity(&s, maxEntries)
+}
+
+// ResetCache clears the cached results of a cacheable series, it does nothing for other series.
+func (s series) ResetCache() {}
+
 func (s series) Immutable() Series {
 	return newImmutableSeries(&s)
 }
 
 //Operation for multiple series calculation
+//
+// The result has the type of seriess[0], so the results of operate are coerced to it.
+// Known bug: mixing an Int series first with a Float series silently truncates the
+// results to integers, use OperationTyped to choose the result type.
 func Operation(operate func(index int, eles ...Element) interface{}, seriess ...Series) (Series, error) {
 	if len(seriess) == 0 {
 		return nil, errors.New("seriess num must > 0")
 	}
+	return OperationTyped(seriess[0].Type(), operate, seriess...)
+}
+
+// OperationTyped is like Operation but the result is a Series of type t.
+func OperationTyped(t Type, operate func(index int, eles ...Element) interface{}, seriess ...Series) (Series, error) {
+	if len(seriess) == 0 {
+		return nil, errors.New("seriess num must > 0")
+	}
+	switch t {
+	case String, Int, Float, Bool, DateTime, Int64, Complex, Categorical:
+	default:
+		return nil, fmt.Errorf("unknown type %v", t)
+	}
 	sl := seriess[0].Len()
 	maxLen := sl
 	for i := 1; i < len(seriess); i++ {
@@ -1380,7 +2528,6 @@ func Operation(operate func(index int, eles ...Element) interface{}, seriess ...
 		}
 	}
 
-	t := seriess[0].Type()
 	eles := t.emptyElements(maxLen)
 	for i := 0; i < maxLen; i++ {
 		operateParam := make([]Element, len(seriess))
@@ -1436,6 +2583,66 @@ func (s series) Slice(start, end int) Series {
 	return ret
 }
 
+// SliceStep returns every step-th element from start to end-1 index, or from start down to
+// end+1 index for a negative step, so SliceStep(Len()-1, -1, -1) reverses the series.
+// Unlike Slice, the elements are copied.
+func (s series) SliceStep(start, end, step int) Series {
+	if s.err != nil {
+		return &s
+	}
+	var idx []int
+	switch {
+	case step > 0 && start <= end && start >= 0 && end <= s.Len():
+		for i := start; i < end; i += step {
+			idx = append(idx, i)
+		}
+	case step < 0 && end <= start && end >= -1 && start < s.Len():
+		for i := start; i > end; i += step {
+			idx = append(idx, i)
+		}
+	default:
+		empty := s.Empty()
+		if step == 0 {
+			empty.SetErr(fmt.Errorf("slice step must not be zero"))
+		} else {
+			empty.SetErr(fmt.Errorf("slice index out of bounds"))
+		}
+		return empty
+	}
+	ret := s.Subset(idx)
+	ret.SetName(fmt.Sprintf("%s_SliceStep(%d,%d,%d)", s.name, start, end, step))
+	return ret
+}
+
+// Head returns the first n elements of the Series, n is clamped to [0, Len()].
+// Like Slice, the returned Series shares the elements with the Series.
+func (s series) Head(n int) Series {
+	n = clampLen(n, s.Len())
+	ret := s.Slice(0, n)
+	ret.SetName(fmt.Sprintf("%s_Head(%d)", s.name, n))
+	return ret
+}
+
+// Tail returns the last n elements of the Series, n is clamped to [0, Len()].
+// Like Slice, the returned Series shares the elements with the Series.
+func (s series) Tail(n int) Series {
+	n = clampLen(n, s.Len())
+	ret := s.Slice(s.Len()-n, s.Len())
+	ret.SetName(fmt.Sprintf("%s_Tail(%d)", s.name, n))
+	return ret
+}
+
+// clampLen clamps n to [0, l].
+func clampLen(n, l int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > l {
+		return l
+	}
+	return n
+}
+
 func (s *series) SetName(name string) {
 	s.name = name
 }
@@ -1471,3 +2678,44 @@ func (s *series) Filter(ff FilterFunction) Series {
 	}
 	return ret
 }
+
+// ForEach calls f with each element of the series in order and stops as soon as f returns false.
+// The elements are not copied, so modifying them modifies the series.
+func (s *series) ForEach(f func(i int, e Element) bool) {
+	for i := 0; i < s.Len(); i++ {
+		if !f(i, s.elements.Elem(i)) {
+			return
+		}
+	}
+}
+
+// Find returns the index of the first element matching the FilterFunction, or -1 if there is none.
+func (s *series) Find(ff FilterFunction) int {
+	for i := 0; i < s.Len(); i++ {
+		if ff(s.elements.Elem(i), i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexOf returns the index of the first element equal to value converted to the type of the series,
+// or -1 if there is none. NaN elements never match, and neither do the non-integral values on an
+// Int or Int64 series.
+func (s *series) IndexOf(value interface{}) int {
+	if s.t == Int || s.t == Int64 {
+		var f floatElement
+		f.Set(value)
+		if !f.IsNA() && f.Float() != math.Trunc(f.Float()) {
+			return -1
+		}
+	}
+	target := s.t.emptyElements(1).Elem(0)
+	target.Set(value)
+	if target.IsNA() {
+		return -1
+	}
+	return s.Find(func(ele Element, index int) bool {
+		return ele.Eq(target)
+	})
+}
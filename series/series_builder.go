@@ -0,0 +1,50 @@
+package series
+
+// Builder builds a Series element by element into preallocated elements
+type Builder interface {
+	// Add converts v to the type of the builder and adds it as the next element.
+	// The elements grow like Append once the capacity is used up.
+	Add(v interface{})
+	// Build returns the Series of the added elements and resets the builder.
+	Build() Series
+}
+
+// NewBuilder returns a Builder of a Series of type t which preallocates capacity elements.
+func NewBuilder(t Type, capacity int) Builder {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &builder{
+		t:        t,
+		capacity: capacity,
+		elements: t.emptyElements(capacity),
+	}
+}
+
+type builder struct {
+	t        Type
+	capacity int
+	elements Elements
+	n        int
+}
+
+func (b *builder) Add(v interface{}) {
+	if b.n < b.elements.Len() {
+		b.elements.Elem(b.n).Set(v)
+	} else {
+		ele := b.t.emptyElements(1).Elem(0)
+		ele.Set(v)
+		b.elements = b.elements.AppendOne(ele)
+	}
+	b.n++
+}
+
+func (b *builder) Build() Series {
+	ret := &series{
+		t:        b.t,
+		elements: b.elements.Slice(0, b.n),
+	}
+	b.elements = b.t.emptyElements(b.capacity)
+	b.n = 0
+	return ret
+}
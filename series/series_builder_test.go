@@ -0,0 +1,59 @@
+package series
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	tests := []struct {
+		t        Type
+		capacity int
+		values   []interface{}
+		expected []string
+	}{
+		{
+			Int,
+			3,
+			[]interface{}{1, "2", nil},
+			[]string{"1", "2", "NaN"},
+		},
+		{
+			Float,
+			1,
+			[]interface{}{1.5, "a", 3, Ints([]int{4}).Elem(0)},
+			[]string{"1.500000", "NaN", "3.000000", "4.000000"},
+		},
+		{
+			String,
+			0,
+			[]interface{}{"a", 1, true},
+			[]string{"a", "1", "true"},
+		},
+		{
+			Bool,
+			10,
+			[]interface{}{},
+			[]string{},
+		},
+	}
+	for testnum, test := range tests {
+		b := NewBuilder(test.t, test.capacity)
+		for _, v := range test.values {
+			b.Add(v)
+		}
+		received := b.Build()
+		if received.Type() != test.t || !reflect.DeepEqual(test.expected, received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+
+		// the builder is reset and does not modify the built series
+		b.Add(test.values)
+		if again := b.Build(); again.Len() != 1 || !reflect.DeepEqual(test.expected, received.Records()) {
+			t.Errorf("Test:%v\nExpected the builder to be reset, received %v and %v", testnum, again, received)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package series
+
+import (
+	"fmt"
+)
+
+// OneHot returns one Bool Series per distinct non-NaN value of the series, true where the
+// element has that value, and the values as labels. The labels are the values formatted
+// like Records in the order of Order, each Series is named name_label. An extra NaN column
+// flagging the NaN elements is appended when withNaN is true.
+func (s series) OneHot(withNaN bool) ([]Series, []string) {
+	if err := s.err; err != nil {
+		return nil, nil
+	}
+	labels, codes := s.categorize()
+	nanCode := len(labels)
+	if withNaN {
+		labels = append(labels, NaN)
+	}
+	columns := make([][]bool, len(labels))
+	for k := range columns {
+		columns[k] = make([]bool, s.Len())
+	}
+	for i, code := range codes {
+		if code < 0 {
+			if !withNaN {
+				continue
+			}
+			code = nanCode
+		}
+		columns[code][i] = true
+	}
+	ret := make([]Series, len(labels))
+	for k, label := range labels {
+		ret[k] = New(columns[k], Bool, fmt.Sprintf("%s_%s", s.name, label))
+	}
+	return ret, labels
+}
+
+// LabelEncode returns an Int Series of the codes of the values of the series and the mapping
+// from the values formatted like Records to their codes. The codes are assigned in the order
+// of Order, like the labels of OneHot, and the NaN elements are encoded as -1.
+func (s series) LabelEncode() (Series, map[string]int) {
+	if err := s.err; err != nil {
+		return &s, nil
+	}
+	labels, codes := s.categorize()
+	mapping := make(map[string]int, len(labels))
+	for code, label := range labels {
+		mapping[label] = code
+	}
+	return New(codes, Int, fmt.Sprintf("%s_LabelEncode", s.name)), mapping
+}
+
+// categorize returns the distinct non-NaN values of the series formatted like Records
+// in the order of Order, and the index of the value of each element among them, -1 for NaN.
+func (s series) categorize() ([]string, []int) {
+	labels := []string{}
+	codes := make([]int, s.Len())
+	seen := make(map[string]int)
+	for _, i := range s.Order(false) {
+		ele := s.elements.Elem(i)
+		if ele.IsNA() {
+			codes[i] = -1
+			continue
+		}
+		label := ele.String()
+		code, ok := seen[label]
+		if !ok {
+			code = len(labels)
+			labels = append(labels, label)
+			seen[label] = code
+		}
+		codes[i] = code
+	}
+	return labels, codes
+}
@@ -0,0 +1,123 @@
+package series
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSeries_OneHot(t *testing.T) {
+	tests := []struct {
+		series   Series
+		withNaN  bool
+		labels   []string
+		expected [][]bool
+	}{
+		{
+			Strings([]string{"b", "a", NaN, "b"}),
+			false,
+			[]string{"a", "b"},
+			[][]bool{
+				{false, true, false, false},
+				{true, false, false, true},
+			},
+		},
+		{
+			Categoricals([]string{"b", "a", NaN, "b"}),
+			true,
+			[]string{"a", "b", NaN},
+			[][]bool{
+				{false, true, false, false},
+				{true, false, false, true},
+				{false, false, true, false},
+			},
+		},
+		{
+			Ints([]int{10, 9, 10}),
+			true,
+			[]string{"9", "10", NaN},
+			[][]bool{
+				{false, true, false},
+				{true, false, true},
+				{false, false, false},
+			},
+		},
+		{
+			Strings([]string{}),
+			false,
+			[]string{},
+			[][]bool{},
+		},
+	}
+
+	for testnum, test := range tests {
+		test.series.SetName("x")
+		columns, labels := test.series.OneHot(test.withNaN)
+		if !reflect.DeepEqual(test.labels, labels) {
+			t.Errorf(
+				"Test-Labels:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.labels, labels,
+			)
+		}
+		received := make([][]bool, len(columns))
+		for k, column := range columns {
+			received[k], _ = column.Bool()
+			if name := "x_" + labels[k]; column.Name() != name || column.Type() != Bool {
+				t.Errorf("Test:%v\nExpected Bool Series named %v, received %v %v", testnum, name, column.Type(), column.Name())
+			}
+		}
+		if !reflect.DeepEqual(test.expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_LabelEncode(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected []int
+		mapping  map[string]int
+	}{
+		{
+			Strings([]string{"b", "a", NaN, "b", "c"}),
+			[]int{1, 0, -1, 1, 2},
+			map[string]int{"a": 0, "b": 1, "c": 2},
+		},
+		{
+			Categoricals([]string{"z", "y", "z"}),
+			[]int{1, 0, 1},
+			map[string]int{"y": 0, "z": 1},
+		},
+		{
+			Floats([]string{"2.5", "-1", NaN}),
+			[]int{1, 0, -1},
+			map[string]int{"-1.000000": 0, "2.500000": 1},
+		},
+		{
+			Strings([]string{}),
+			[]int{},
+			map[string]int{},
+		},
+	}
+
+	for testnum, test := range tests {
+		test.series.SetName("x")
+		encoded, mapping := test.series.LabelEncode()
+		received, err := encoded.Int()
+		if err != nil || encoded.Type() != Int || encoded.Name() != "x_LabelEncode" ||
+			!reflect.DeepEqual(test.expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, encoded,
+			)
+		}
+		if !reflect.DeepEqual(test.mapping, mapping) {
+			t.Errorf(
+				"Test-Mapping:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.mapping, mapping,
+			)
+		}
+	}
+}
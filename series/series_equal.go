@@ -0,0 +1,99 @@
+package series
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+)
+
+// Equal returns whether the series has the same type, length and elements as other.
+// Elements are compared with Element.Eq, two NaN elements are considered equal.
+func (s series) Equal(other Series) bool {
+	return s.equalWith(other, func(a, b Element) bool {
+		return a.Eq(b)
+	})
+}
+
+// EqualApprox is like Equal but numeric elements are considered equal
+// when their absolute difference is not greater than tol.
+func (s series) EqualApprox(other Series, tol float64) bool {
+	if s.t == String || s.t == Categorical {
+		return s.Equal(other)
+	}
+	return s.equalWith(other, func(a, b Element) bool {
+		return math.Abs(a.Float()-b.Float()) <= tol
+	})
+}
+
+// equalWith compares the series and other element by element with eq,
+// eq is only called when both elements are not NaN.
+func (s series) equalWith(other Series, eq func(a, b Element) bool) bool {
+	if other == nil || s.t != other.Type() || s.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < s.Len(); i++ {
+		a := s.elements.Elem(i)
+		b := other.Elem(i)
+		if a.IsNA() || b.IsNA() {
+			if a.IsNA() != b.IsNA() {
+				return false
+			}
+			continue
+		}
+		if !eq(a, b) {
+			return false
+		}
+	}
+	return true
+}
+
+// Fingerprint returns a hash of the type and the elements of the series, the name is not hashed.
+// Series which are Equal have the same fingerprint.
+func (s series) Fingerprint() uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s.t))
+	var buf [9]byte
+	for i := 0; i < s.Len(); i++ {
+		e := s.elements.Elem(i)
+		if e.IsNA() {
+			buf[0] = 0
+			h.Write(buf[:1])
+			continue
+		}
+		buf[0] = 1
+		switch s.t {
+		case String, Categorical:
+			str := e.String()
+			binary.LittleEndian.PutUint64(buf[1:], uint64(len(str)))
+			h.Write(buf[:])
+			h.Write([]byte(str))
+			continue
+		case Float:
+			binary.LittleEndian.PutUint64(buf[1:], floatBits(e.Float()))
+		case Complex:
+			c := e.Val().(complex128)
+			binary.LittleEndian.PutUint64(buf[1:], floatBits(real(c)))
+			h.Write(buf[:])
+			binary.LittleEndian.PutUint64(buf[1:], floatBits(imag(c)))
+		case Bool:
+			b, _ := e.Bool()
+			binary.LittleEndian.PutUint64(buf[1:], 0)
+			if b {
+				buf[1] = 1
+			}
+		default:
+			v, _ := e.Int()
+			binary.LittleEndian.PutUint64(buf[1:], uint64(v))
+		}
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+// floatBits returns the bits of f hashed by Fingerprint, -0 is hashed like 0 as they are Equal.
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		f = 0
+	}
+	return math.Float64bits(f)
+}
@@ -0,0 +1,188 @@
+package series
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSeries_Equal(t *testing.T) {
+	tests := []struct {
+		series   Series
+		other    Series
+		expected bool
+	}{
+		{
+			Floats([]string{"1.5", NaN, "3"}),
+			Floats([]string{"1.5", NaN, "3"}),
+			true,
+		},
+		{
+			Floats([]string{"1.5", NaN, "3"}),
+			Floats([]string{"1.5", "2", "3"}),
+			false,
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			Floats([]float64{1, 2, 3}),
+			false,
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			Ints([]int{1, 2}),
+			false,
+		},
+		{
+			Strings([]string{"a", NaN, "b"}),
+			Strings([]string{"a", NaN, "b"}),
+			true,
+		},
+		{
+			Bools([]bool{true, false}),
+			Bools([]bool{true, true}),
+			false,
+		},
+		{
+			Floats([]float64{0.3000001}),
+			Floats([]float64{0.3}),
+			false,
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Equal(test.other)
+		if received != test.expected {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_EqualApprox(t *testing.T) {
+	tests := []struct {
+		series   Series
+		other    Series
+		tol      float64
+		expected bool
+	}{
+		{
+			Floats([]float64{0.3000001, 1}),
+			Floats([]float64{0.3, 1}),
+			1e-6,
+			true,
+		},
+		{
+			Floats([]string{"1", NaN}),
+			Floats([]string{"1.05", NaN}),
+			0.1,
+			true,
+		},
+		{
+			Floats([]string{"1", NaN}),
+			Floats([]string{"1.5", NaN}),
+			0.1,
+			false,
+		},
+		{
+			Floats([]string{"1", NaN}),
+			Floats([]string{"1", "1"}),
+			0.1,
+			false,
+		},
+		{
+			Ints([]int{1, 2}),
+			Ints([]int{1, 3}),
+			1,
+			true,
+		},
+		{
+			Strings([]string{"a", "b"}),
+			Strings([]string{"a", "c"}),
+			1,
+			false,
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.EqualApprox(test.other, test.tol)
+		if received != test.expected {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_Fingerprint(t *testing.T) {
+	tests := []struct {
+		series   Series
+		other    Series
+		expected bool
+	}{
+		{
+			Floats([]string{"1.5", NaN, "3"}),
+			Floats([]string{"1.5", NaN, "3"}),
+			true,
+		},
+		{
+			Floats([]float64{1, 2, 3}),
+			Floats([]float64{1, 2, 3.0000000001}),
+			false,
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			Floats([]float64{1, 2, 3}),
+			false,
+		},
+		{
+			Ints([]string{"1", NaN}),
+			Ints([]int{1, 0}),
+			false,
+		},
+		{
+			Strings([]string{"ab", "c"}),
+			Strings([]string{"a", "bc"}),
+			false,
+		},
+		{
+			Bools([]bool{true, false}),
+			Bools([]string{"true", "false"}),
+			true,
+		},
+		{
+			Times([]time.Time{time.Unix(1, 0)}),
+			Times([]time.Time{time.Unix(1, 1)}),
+			false,
+		},
+		{
+			Floats([]float64{math.Copysign(0, -1), 1}),
+			Floats([]float64{0, 1}),
+			true,
+		},
+		{
+			Complexes([]complex128{complex(math.Copysign(0, -1), 1)}),
+			Complexes([]complex128{complex(0, 1)}),
+			true,
+		},
+	}
+	for testnum, test := range tests {
+		if test.expected && !test.series.Equal(test.other) {
+			t.Errorf("Test:%v\nExpected equal series:\n%v\n%v", testnum, test.series, test.other)
+		}
+		test.series.SetName("a")
+		test.other.SetName("b")
+		received := test.series.Fingerprint() == test.other.Fingerprint()
+		if received != test.expected {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+		if cached := test.series.CacheAble().Fingerprint(); cached != test.series.Fingerprint() {
+			t.Errorf("Test:%v\nExpected cached fingerprint %v, received %v", testnum, test.series.Fingerprint(), cached)
+		}
+	}
+}
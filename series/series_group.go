@@ -0,0 +1,81 @@
+package series
+
+import (
+	"fmt"
+)
+
+// GroupBy partitions the series by the values of the aligned keys, the Series of each group
+// keeps the order of its elements and is mapped to the value of its key. NaN keys are skipped.
+// It returns nil if keys has a different length or either series has errors.
+func (s series) GroupBy(keys Series) map[interface{}]Series {
+	first, groups, err := s.groupIndexes(keys)
+	if err != nil {
+		return nil
+	}
+	ret := make(map[interface{}]Series, len(groups))
+	for g, idx := range groups {
+		ret[keys.Elem(first[g]).Val()] = s.Subset(idx)
+	}
+	return ret
+}
+
+// GroupByAgg groups the series like GroupBy and applies agg to each group, it returns the distinct
+// keys ordered by first appearance and the Float Series of the aggregated value of each group.
+func (s series) GroupByAgg(keys Series, agg func(Series) float64) (Series, Series) {
+	name := fmt.Sprintf("%s_GroupByAgg(%s)", s.name, keys.Name())
+	first, groups, err := s.groupIndexes(keys)
+	if err != nil {
+		err = fmt.Errorf("groupby error: %v", err)
+		groupKeys := New([]int{}, keys.Type(), keys.Name())
+		groupKeys.SetErr(err)
+		values := New([]float64{}, Float, name)
+		values.SetErr(err)
+		return groupKeys, values
+	}
+	eles := make(floatElements, len(groups))
+	for g, idx := range groups {
+		group := &series{
+			name:     s.name,
+			elements: s.elements.Get(idx...),
+			t:        s.t,
+		}
+		eles[g].SetFloat(agg(group))
+	}
+	values := &series{
+		name:     name,
+		elements: eles,
+		t:        Float,
+		err:      nil,
+	}
+	return keys.Subset(first), values
+}
+
+// groupIndexes returns the indexes of the elements of each group of the aligned keys in the
+// order of first appearance of the keys, and the index of that first appearance. NaN keys are skipped.
+func (s series) groupIndexes(keys Series) (first []int, groups [][]int, err error) {
+	if err := s.err; err != nil {
+		return nil, nil, err
+	}
+	if err := keys.Error(); err != nil {
+		return nil, nil, fmt.Errorf("argument has errors: %v", err)
+	}
+	if keys.Len() != s.Len() {
+		return nil, nil, fmt.Errorf("length mismatch (%d != %d)", s.Len(), keys.Len())
+	}
+	index := make(map[interface{}]int)
+	for i := 0; i < keys.Len(); i++ {
+		key := keys.Elem(i)
+		if key.IsNA() {
+			continue
+		}
+		g, ok := index[key.Val()]
+		if !ok {
+			g = len(groups)
+			index[key.Val()] = g
+			first = append(first, i)
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], i)
+	}
+	return first, groups, nil
+}
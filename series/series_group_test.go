@@ -0,0 +1,118 @@
+package series
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSeries_GroupBy(t *testing.T) {
+	tests := []struct {
+		series   Series
+		keys     Series
+		expected map[interface{}][]string
+	}{
+		{
+			Floats([]float64{1, 2, 3, 4, 5}),
+			Strings([]string{"a", "b", "a", NaN, "b"}),
+			map[interface{}][]string{
+				"a": {"1.000000", "3.000000"},
+				"b": {"2.000000", "5.000000"},
+			},
+		},
+		{
+			Strings([]string{"x", "y", "z"}),
+			Ints([]int{2, 2, 1}),
+			map[interface{}][]string{
+				2: {"x", "y"},
+				1: {"z"},
+			},
+		},
+		{
+			Ints([]int{}),
+			Ints([]int{}),
+			map[interface{}][]string{},
+		},
+	}
+
+	for testnum, test := range tests {
+		groups := test.series.GroupBy(test.keys)
+		received := make(map[interface{}][]string, len(groups))
+		for key, group := range groups {
+			if group.Type() != test.series.Type() {
+				t.Errorf("Test:%v\nExpected type %v, received %v", testnum, test.series.Type(), group.Type())
+			}
+			received[key] = group.Records()
+		}
+		if !reflect.DeepEqual(test.expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	if groups := Ints([]int{1, 2}).GroupBy(Ints([]int{1})); groups != nil {
+		t.Errorf("Expected nil on length mismatch, received %v", groups)
+	}
+}
+
+func TestSeries_GroupByAgg(t *testing.T) {
+	tests := []struct {
+		series   Series
+		keys     Series
+		agg      func(Series) float64
+		expKeys  Series
+		expected Series
+	}{
+		{
+			Floats([]float64{1, 2, 3, 4, 5}),
+			Strings([]string{"b", "a", "b", NaN, "a"}),
+			func(s Series) float64 {
+				return s.Mean()
+			},
+			Strings([]string{"b", "a"}),
+			Floats([]float64{2, 3.5}),
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			Ints([]int{7, 7, 7}),
+			func(s Series) float64 {
+				return s.Sum()
+			},
+			Ints([]int{7}),
+			Floats([]float64{6}),
+		},
+		{
+			Ints([]int{}),
+			Ints([]int{}),
+			func(s Series) float64 {
+				return s.Sum()
+			},
+			Ints([]int{}),
+			Floats([]float64{}),
+		},
+	}
+
+	for testnum, test := range tests {
+		keys, values := test.series.GroupByAgg(test.keys, test.agg)
+		if keys.Type() != test.keys.Type() || !reflect.DeepEqual(test.expKeys.Records(), keys.Records()) {
+			t.Errorf(
+				"Test-Keys:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expKeys, keys,
+			)
+		}
+		if values.Type() != Float || !reflect.DeepEqual(test.expected.Records(), values.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, values,
+			)
+		}
+	}
+
+	keys, values := Ints([]int{1, 2}).GroupByAgg(Ints([]int{1}), func(s Series) float64 {
+		return s.Sum()
+	})
+	if keys.Error() == nil || values.Error() == nil {
+		t.Errorf("Expected length mismatch error")
+	}
+}
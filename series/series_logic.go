@@ -7,55 +7,135 @@ import (
 
 
 func (s series) And(in interface{}) Series {
-	inSeries := New(in, Bool, "")
-	result, err := Operation(func(index int, eles ...Element) interface{} {
-		e0b, err := eles[0].Bool()
-		if err != nil {
-			return nil
-		}
-		e1b, err := eles[1].Bool()
-		if err != nil {
-			return nil
-		}
-		return e0b && e1b
-	}, &s, inSeries)
+	return s.logic(in, func(a, b bool) bool {
+		return a && b
+	})
+}
+
+func (s series) Or(in interface{}) Series {
+	return s.logic(in, func(a, b bool) bool {
+		return a || b
+	})
+}
+
+func (s series) Xor(in interface{}) Series {
+	return s.logic(in, func(a, b bool) bool {
+		return a != b
+	})
+}
+
+func (s series) Not() Series {
+	bools := make([]bool, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		bools[i] = !isTrue(s.elements.Elem(i))
+	}
+	return New(bools, Bool, fmt.Sprintf("Not(%s)", s.Name()))
+}
+
+// logic applies the boolean operator f to the truthiness of the elements of the series and in.
+func (s series) logic(in interface{}, f func(a, b bool) bool) Series {
+	result, err := OperationTyped(Bool, func(index int, eles ...Element) interface{} {
+		return f(isTrue(eles[0]), isTrue(eles[1]))
+	}, &s, logicOperand(in))
 	if err != nil {
 		log.Panic(err)
 	}
-	return result	
+	return result
 }
 
-func (s series) Or(in interface{}) Series {
-	inSeries := New(in, Bool, "")
-	result, err := Operation(func(index int, eles ...Element) interface{} {
-		e0b, err := eles[0].Bool()
-		if err != nil {
-			return nil
+// logicOperand converts in to a Series, the numbers are kept so they are true when not zero.
+func logicOperand(in interface{}) Series {
+	switch v := in.(type) {
+	case Series:
+		return v
+	case int, []int:
+		return New(v, Int, "")
+	case float64, []float64:
+		return New(v, Float, "")
+	}
+	return New(in, Bool, "")
+}
+
+// isTrue returns the truthiness of an element used by the logical operations, NaN is false.
+func isTrue(ele Element) bool {
+	return !ele.IsNA() && truthy(ele)
+}
+
+// Any returns whether any element of the series is true. NaN elements are ignored.
+// Numeric elements are true when they are not zero, String elements are parsed by Element.Bool.
+func (s series) Any() bool {
+	for i := 0; i < s.Len(); i++ {
+		ele := s.elements.Elem(i)
+		if ele.IsNA() {
+			continue
 		}
-		e1b, err := eles[1].Bool()
-		if err != nil {
-			return nil
+		if truthy(ele) {
+			return true
 		}
-		return e0b || e1b
-	}, &s, inSeries)
-	if err != nil {
-		log.Panic(err)
 	}
-	return result
+	return false
 }
 
-func (s series) Not() Series {
-	result := s.Map(func(ele Element, index int) Element {
-		ret := ele.Copy()
-		b, err := ele.Bool()
-		if err != nil {
-			ret.Set(nil)
-			return ret
-		} else {
-			ret.SetBool(!b)
+// All returns whether all elements of the series are true. NaN elements are ignored,
+// so All returns true for an empty or all-NaN series.
+// Numeric elements are true when they are not zero, String elements are parsed by Element.Bool.
+func (s series) All() bool {
+	for i := 0; i < s.Len(); i++ {
+		ele := s.elements.Elem(i)
+		if ele.IsNA() {
+			continue
+		}
+		if !truthy(ele) {
+			return false
 		}
-		return ret
+	}
+	return true
+}
+
+// CrossAbove returns a Bool Series which is true at index i where s[i] > other[i] and s[i-1] <= other[i-1].
+// The first element is false, and so are the comparisons involving NaN. An other of length 1 is broadcast.
+func (s series) CrossAbove(other Series) Series {
+	return s.cross(other, fmt.Sprintf("CrossAbove(%s,%s)", s.name, other.Name()), func(x, y float64) bool {
+		return x > y
+	}, func(x, y float64) bool {
+		return x <= y
 	})
-	result.SetName(fmt.Sprintf("Not(%s)", s.Name()))
-	return result
-}
\ No newline at end of file
+}
+
+// CrossBelow returns a Bool Series which is true at index i where s[i] < other[i] and s[i-1] >= other[i-1].
+// The first element is false, and so are the comparisons involving NaN. An other of length 1 is broadcast.
+func (s series) CrossBelow(other Series) Series {
+	return s.cross(other, fmt.Sprintf("CrossBelow(%s,%s)", s.name, other.Name()), func(x, y float64) bool {
+		return x < y
+	}, func(x, y float64) bool {
+		return x >= y
+	})
+}
+
+// cross returns a Bool Series which is true at index i where after holds for s[i] and other[i]
+// and before holds for s[i-1] and other[i-1].
+func (s series) cross(other Series, name string, after, before func(x, y float64) bool) Series {
+	if err := s.checkOperand(other); err != nil {
+		empty := New([]bool{}, Bool, name)
+		empty.SetErr(fmt.Errorf("cross error: %v", err))
+		return empty
+	}
+	xs := s.Float()
+	ys := s.operandFloats(other)
+	crosses := make([]bool, len(xs))
+	for i := 1; i < len(xs); i++ {
+		crosses[i] = after(xs[i], ys[i]) && before(xs[i-1], ys[i-1])
+	}
+	return New(crosses, Bool, name)
+}
+
+// truthy returns the truthiness of a non-NaN element.
+func truthy(ele Element) bool {
+	switch ele.Type() {
+	case Bool, String, Categorical:
+		b, err := ele.Bool()
+		return err == nil && b
+	default:
+		return ele.Float() != 0
+	}
+}
@@ -58,9 +58,9 @@ func TestSeries_Logic(t *testing.T) {
 		{
 			Bools([]string{"false", "true", "false", "false", "123"}),
 			[]int	  {7, 0, 1, 0, 0},
-			Bools([]string{"NaN", "false", "false", "false", "NaN"}),
-			Bools([]string{"NaN", "true", "true", "false", "NaN"}),
-			Bools([]string{"true", "false", "true", "true", "NaN"}),
+			Bools([]string{"false", "false", "false", "false", "false"}),
+			Bools([]string{"true", "true", "true", "false", "false"}),
+			Bools([]string{"true", "false", "true", "true", "true"}),
 		},
 	}
 
@@ -96,3 +96,176 @@ func TestSeries_Logic(t *testing.T) {
 	}
 }
 
+func TestSeries_XorNot(t *testing.T) {
+	tests := []struct {
+		series      Series
+		another     interface{}
+		xorExpected Series
+		notExpected Series
+	}{
+		{
+			Bools([]string{"false", "true", "false", "true", NaN}),
+			[]string{"true", "true", "false", "false", "true"},
+			Bools([]string{"true", "false", "false", "true", "true"}),
+			Bools([]string{"true", "false", "true", "false", "true"}),
+		},
+		{
+			Bools([]string{"false", "true", "true"}),
+			[]int{1, 7, 0},
+			Bools([]string{"true", "false", "true"}),
+			Bools([]string{"true", "false", "false"}),
+		},
+		{
+			Ints([]string{"0", "1", "-3", NaN}),
+			"true",
+			Bools([]string{"true", "false", "false", "true"}),
+			Bools([]string{"true", "false", "false", "true"}),
+		},
+		{
+			Floats([]string{"0", "0.5", NaN}),
+			[]bool{false, false, false},
+			Bools([]string{"false", "true", "false"}),
+			Bools([]string{"true", "false", "true"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.xorExpected.Records()
+		received := test.series.Xor(test.another).Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-Xor:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+
+		expected = test.notExpected.Records()
+		b := test.series.Not()
+		received = b.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-Not:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		if b.Type() != Bool {
+			t.Errorf("Test-Not:%v\nExpected type %v, received %v", testnum, Bool, b.Type())
+		}
+		if b = test.series.And(test.another); b.Type() != Bool {
+			t.Errorf("Test-And:%v\nExpected type %v, received %v", testnum, Bool, b.Type())
+		}
+	}
+}
+
+func TestSeries_AnyAll(t *testing.T) {
+	tests := []struct {
+		series      Series
+		anyExpected bool
+		allExpected bool
+	}{
+		{
+			Bools([]string{"false", "true", "false"}),
+			true,
+			false,
+		},
+		{
+			Bools([]string{"true", NaN, "true"}),
+			true,
+			true,
+		},
+		{
+			Bools([]string{"false", NaN, "false"}),
+			false,
+			false,
+		},
+		{
+			Ints([]int{0, 3, -1}),
+			true,
+			false,
+		},
+		{
+			Floats([]string{"0.5", NaN, "-2"}),
+			true,
+			true,
+		},
+		{
+			Strings([]string{"f", "abc", "0"}),
+			false,
+			false,
+		},
+		{
+			Floats([]string{NaN, NaN}),
+			false,
+			true,
+		},
+		{
+			Bools([]bool{}),
+			false,
+			true,
+		},
+	}
+
+	for testnum, test := range tests {
+		if received := test.series.Any(); received != test.anyExpected {
+			t.Errorf(
+				"Test-Any:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.anyExpected, received,
+			)
+		}
+		if received := test.series.All(); received != test.allExpected {
+			t.Errorf(
+				"Test-All:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.allExpected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_CrossAboveBelow(t *testing.T) {
+	tests := []struct {
+		series     Series
+		other      Series
+		crossAbove Series
+		crossBelow Series
+	}{
+		{
+			Floats([]string{"1", "3", "3", "1", NaN, "3", "1"}),
+			Floats([]string{"2", "2", "2", "2", "2", "2", "1"}),
+			Bools([]bool{false, true, false, false, false, false, false}),
+			Bools([]bool{false, false, false, true, false, false, false}),
+		},
+		{
+			Ints([]int{5, 1, 2, 2, 3}),
+			Ints([]int{2}),
+			Bools([]bool{false, false, false, false, true}),
+			Bools([]bool{false, true, false, false, false}),
+		},
+		{
+			Floats([]float64{}),
+			Floats([]float64{}),
+			Bools([]bool{}),
+			Bools([]bool{}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.CrossAbove(test.other)
+		if received.Type() != Bool || !reflect.DeepEqual(test.crossAbove.Records(), received.Records()) {
+			t.Errorf(
+				"Test-CrossAbove:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.crossAbove, received,
+			)
+		}
+		received = test.series.CrossBelow(test.other)
+		if received.Type() != Bool || !reflect.DeepEqual(test.crossBelow.Records(), received.Records()) {
+			t.Errorf(
+				"Test-CrossBelow:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.crossBelow, received,
+			)
+		}
+	}
+
+	if err := Ints([]int{1, 2, 3}).CrossAbove(Ints([]int{1, 2})).Error(); err == nil {
+		t.Errorf("Expected length mismatch error")
+	}
+}
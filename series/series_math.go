@@ -0,0 +1,232 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Round rounds the values of the series to the given number of decimals and returning a new Series object.
+// Rounding a series of type Int, Int64 or Bool returns a copy.
+func (s series) Round(decimals int) Series {
+	scale := math.Pow(10, float64(decimals))
+	sm := s.mapRounding(func(f float64) float64 {
+		return math.Round(f*scale) / scale
+	})
+	sm.SetName(fmt.Sprintf("Round(%s,%d)", s.name, decimals))
+	return sm
+}
+
+// Floor returns the greatest integer value less than or equal to the values of the series and returning a new Series object.
+func (s series) Floor() Series {
+	sm := s.mapRounding(math.Floor)
+	sm.SetName(fmt.Sprintf("Floor(%s)", s.name))
+	return sm
+}
+
+// Ceil returns the least integer value greater than or equal to the values of the series and returning a new Series object.
+func (s series) Ceil() Series {
+	sm := s.mapRounding(math.Ceil)
+	sm.SetName(fmt.Sprintf("Ceil(%s)", s.name))
+	return sm
+}
+
+// Trunc returns the integer part of the values of the series and returning a new Series object.
+func (s series) Trunc() Series {
+	sm := s.mapRounding(math.Trunc)
+	sm.SetName(fmt.Sprintf("Trunc(%s)", s.name))
+	return sm
+}
+
+// mapRounding applies the rounding function f to the elements of the series,
+// values of type Int, Int64 and Bool are integral already so they are just copied.
+func (s series) mapRounding(f func(float64) float64) Series {
+	if s.t == Int || s.t == Int64 || s.t == Bool {
+		return s.Copy()
+	}
+	return s.Map(func(e Element, index int) Element {
+		result := e.Copy()
+		if result.IsNA() {
+			return result
+		}
+		result.Set(f(result.Float()))
+		return result
+	})
+}
+
+// Log returns the natural logarithm of the values of the series as a Float Series.
+// Negative values yield NaN.
+func (s series) Log() Series {
+	sm := s.mapFloat(math.Log)
+	sm.SetName(fmt.Sprintf("Log(%s)", s.name))
+	return sm
+}
+
+// Log10 returns the decimal logarithm of the values of the series as a Float Series.
+// Negative values yield NaN.
+func (s series) Log10() Series {
+	sm := s.mapFloat(math.Log10)
+	sm.SetName(fmt.Sprintf("Log10(%s)", s.name))
+	return sm
+}
+
+// Exp returns e**x of the values of the series as a Float Series.
+func (s series) Exp() Series {
+	sm := s.mapFloat(math.Exp)
+	sm.SetName(fmt.Sprintf("Exp(%s)", s.name))
+	return sm
+}
+
+// Sqrt returns the square root of the values of the series as a Float Series.
+// Negative values yield NaN.
+func (s series) Sqrt() Series {
+	sm := s.mapFloat(math.Sqrt)
+	sm.SetName(fmt.Sprintf("Sqrt(%s)", s.name))
+	return sm
+}
+
+// Pow returns x**p of the values of the series as a Float Series.
+func (s series) Pow(p float64) Series {
+	sm := s.mapFloat(func(f float64) float64 {
+		return math.Pow(f, p)
+	})
+	sm.SetName(fmt.Sprintf("Pow(%s,%v)", s.name, p))
+	return sm
+}
+
+// PowSeries returns x[i]**other[i] of the values of the series as a Float Series,
+// an other of length 1 is broadcast to all the elements.
+func (s series) PowSeries(other Series) Series {
+	name := fmt.Sprintf("Pow(%s,%s)", s.name, other.Name())
+	if err := s.checkOperand(other); err != nil {
+		empty := New([]float64{}, Float, name)
+		empty.SetErr(fmt.Errorf("can't pow: %v", err))
+		return empty
+	}
+	exponents := s.operandFloats(other)
+	elements := make(floatElements, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		ele := s.elements.Elem(i)
+		if ele.IsNA() {
+			elements[i].SetNA()
+			continue
+		}
+		elements[i].SetFloat(math.Pow(ele.Float(), exponents[i]))
+	}
+	ret := &series{
+		name:     name,
+		elements: elements,
+		t:        Float,
+		err:      nil,
+	}
+	return ret
+}
+
+// Returns returns the relative change (x[i] - x[i-1]) / x[i-1] of the values of the series
+// as a Float Series, the first element is NaN.
+func (s series) Returns() Series {
+	return s.mapPairs(fmt.Sprintf("Returns(%s)", s.name), func(prev, cur float64) float64 {
+		return (cur - prev) / prev
+	})
+}
+
+// LogReturns returns log(x[i] / x[i-1]) of the values of the series as a Float Series,
+// the first element is NaN. Zero or negative values yield NaN.
+func (s series) LogReturns() Series {
+	return s.mapPairs(fmt.Sprintf("LogReturns(%s)", s.name), func(prev, cur float64) float64 {
+		if prev <= 0 || cur <= 0 {
+			return math.NaN()
+		}
+		return math.Log(cur / prev)
+	})
+}
+
+// mapPairs applies the function f to the float values of each element and its previous element
+// and returns a Float Series. The first element and the pairs with a NaN element are NaN.
+func (s series) mapPairs(name string, f func(prev, cur float64) float64) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	xs := s.Float()
+	elements := make(floatElements, len(xs))
+	for i := range xs {
+		if i == 0 || math.IsNaN(xs[i-1]) || math.IsNaN(xs[i]) {
+			elements[i].SetNA()
+			continue
+		}
+		elements[i].SetFloat(f(xs[i-1], xs[i]))
+	}
+	ret := &series{
+		name:     name,
+		elements: elements,
+		t:        Float,
+		err:      nil,
+	}
+	return ret
+}
+
+// mapFloat applies the function f to the float values of the series and returns a Float Series.
+// The values are converted to a Float Series first as Map keeps the type of the series.
+// NaN elements are kept as NaN.
+func (s series) mapFloat(f func(float64) float64) Series {
+	floats := New(s.Float(), Float, s.name)
+	return floats.Map(func(e Element, index int) Element {
+		result := e.Copy()
+		if !result.IsNA() {
+			result.SetFloat(f(result.Float()))
+		}
+		return result
+	})
+}
+
+// Cut assigns the values of the series to the bins defined by the increasing edges.
+// The bins are right-closed, (edges[i], edges[i+1]], and the first one also includes edges[0].
+// It returns a String Series of labels, or an Int Series of bin indexes when labels is nil.
+// Values outside the edges are NaN. Series of a type other than Int, Int64 and Float return an error.
+func (s series) Cut(edges []float64, labels []string) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	name := fmt.Sprintf("%s_Cut", s.name)
+	t := String
+	if labels == nil {
+		t = Int
+	}
+	fail := func(err error) Series {
+		empty := New([]int{}, t, name)
+		empty.SetErr(fmt.Errorf("cut error: %v", err))
+		return empty
+	}
+	if !s.t.numeric() {
+		return fail(fmt.Errorf("series type %v is not numeric", s.t))
+	}
+	if len(edges) < 2 {
+		return fail(fmt.Errorf("at least two edges are required"))
+	}
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i] > edges[i-1]) {
+			return fail(fmt.Errorf("edges must be strictly increasing"))
+		}
+	}
+	if labels != nil && len(labels) != len(edges)-1 {
+		return fail(fmt.Errorf("%d labels are required for %d edges", len(edges)-1, len(edges)))
+	}
+
+	bins := make([]interface{}, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		f := s.elements.Elem(i).Float()
+		if math.IsNaN(f) || f < edges[0] || f > edges[len(edges)-1] {
+			continue
+		}
+		bin := sort.SearchFloat64s(edges, f) - 1
+		if bin < 0 {
+			bin = 0
+		}
+		if labels == nil {
+			bins[i] = bin
+		} else {
+			bins[i] = labels[bin]
+		}
+	}
+	return New(bins, t, name)
+}
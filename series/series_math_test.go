@@ -0,0 +1,253 @@
+package series
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeries_Rounding(t *testing.T) {
+	tests := []struct {
+		series        Series
+		decimals      int
+		roundExpected Series
+		floorExpected Series
+		ceilExpected  Series
+		truncExpected Series
+	}{
+		{
+			Floats([]string{"1.256", "-2.5", "0.337397", NaN, "1.60979"}),
+			2,
+			Floats([]string{"1.26", "-2.5", "0.34", NaN, "1.61"}),
+			Floats([]string{"1", "-3", "0", NaN, "1"}),
+			Floats([]string{"2", "-2", "1", NaN, "2"}),
+			Floats([]string{"1", "-2", "0", NaN, "1"}),
+		},
+		{
+			Floats([]float64{1.5, -1.5, 2.4}),
+			0,
+			Floats([]float64{2, -2, 2}),
+			Floats([]float64{1, -2, 2}),
+			Floats([]float64{2, -1, 3}),
+			Floats([]float64{1, -1, 2}),
+		},
+		{
+			Ints([]string{"23", "13", NaN, "-64"}),
+			1,
+			Ints([]string{"23", "13", NaN, "-64"}),
+			Ints([]string{"23", "13", NaN, "-64"}),
+			Ints([]string{"23", "13", NaN, "-64"}),
+			Ints([]string{"23", "13", NaN, "-64"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := map[string]Series{
+			"Round": test.series.Round(test.decimals),
+			"Floor": test.series.Floor(),
+			"Ceil":  test.series.Ceil(),
+			"Trunc": test.series.Trunc(),
+		}
+		expected := map[string]Series{
+			"Round": test.roundExpected,
+			"Floor": test.floorExpected,
+			"Ceil":  test.ceilExpected,
+			"Trunc": test.truncExpected,
+		}
+		for op, r := range received {
+			if r.Type() != test.series.Type() {
+				t.Errorf("Test:%v %s\nExpected type %v, received %v", testnum, op, test.series.Type(), r.Type())
+			}
+			if !reflect.DeepEqual(expected[op].Records(), r.Records()) {
+				t.Errorf(
+					"Test:%v %s\nExpected:\n%v\nReceived:\n%v",
+					testnum, op, expected[op], r,
+				)
+			}
+		}
+	}
+}
+
+func TestSeries_MathFunctions(t *testing.T) {
+	tests := []struct {
+		series   Series
+		f        func(s Series) Series
+		expected Series
+	}{
+		{
+			Floats([]string{"1", "-1", "0", NaN}),
+			func(s Series) Series { return s.Log() },
+			Floats([]string{"0", NaN, "-Inf", NaN}),
+		},
+		{
+			Ints([]string{"1", "100", "-10", NaN}),
+			func(s Series) Series { return s.Log10() },
+			Floats([]string{"0", "2", NaN, NaN}),
+		},
+		{
+			Ints([]int{0, 1}),
+			func(s Series) Series { return s.Exp() },
+			Floats([]string{"1", "2.718282"}),
+		},
+		{
+			Floats([]string{"4", "-4", "2.25", NaN}),
+			func(s Series) Series { return s.Sqrt() },
+			Floats([]string{"2", NaN, "1.5", NaN}),
+		},
+		{
+			Ints([]string{"2", "-3", NaN}),
+			func(s Series) Series { return s.Pow(2) },
+			Floats([]string{"4", "9", NaN}),
+		},
+		{
+			Floats([]string{"4", NaN}),
+			func(s Series) Series { return s.Pow(0) },
+			Floats([]string{"1", NaN}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.f(test.series)
+		if received.Type() != Float {
+			t.Errorf("Test:%v\nExpected type %v, received %v", testnum, Float, received.Type())
+		}
+		if !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_PowSeries(t *testing.T) {
+	tests := []struct {
+		series   Series
+		other    Series
+		expected Series
+	}{
+		{
+			Floats([]string{"2", "4", NaN, "9"}),
+			Floats([]string{"3", "0.5", "0", NaN}),
+			Floats([]string{"8", "2", NaN, NaN}),
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			Ints([]int{2}),
+			Floats([]float64{1, 4, 9}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.PowSeries(test.other)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if received.Type() != Float || !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	if err := Floats([]float64{1, 2, 3}).PowSeries(Floats([]float64{1, 2})).Error(); err == nil {
+		t.Errorf("Expected length mismatch error")
+	}
+}
+
+func TestSeries_Returns(t *testing.T) {
+	tests := []struct {
+		series     Series
+		returns    Series
+		logReturns Series
+	}{
+		{
+			Floats([]string{"100", "110", "99", NaN, "99"}),
+			Floats([]string{NaN, "0.1", "-0.1", NaN, NaN}),
+			Floats([]float64{math.NaN(), math.Log(1.1), math.Log(0.9), math.NaN(), math.NaN()}),
+		},
+		{
+			Ints([]int{2, 4, 0, 4, -2}),
+			Floats([]string{NaN, "1", "-1", "+Inf", "-1.5"}),
+			Floats([]float64{math.NaN(), math.Log(2), math.NaN(), math.NaN(), math.NaN()}),
+		},
+		{
+			Floats([]float64{}),
+			Floats([]float64{}),
+			Floats([]float64{}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Returns()
+		if received.Type() != Float || !reflect.DeepEqual(test.returns.Records(), received.Round(10).Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.returns, received,
+			)
+		}
+		received = test.series.LogReturns()
+		if received.Type() != Float || !reflect.DeepEqual(test.logReturns.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.logReturns, received,
+			)
+		}
+	}
+}
+
+func TestSeries_Cut(t *testing.T) {
+	tests := []struct {
+		series   Series
+		edges    []float64
+		labels   []string
+		expected Series
+	}{
+		{
+			Floats([]string{"0", "0.5", "1", "1.5", "3", "-1", NaN}),
+			[]float64{0, 1, 2},
+			[]string{"low", "high"},
+			Strings([]string{"low", "low", "low", "high", NaN, NaN, NaN}),
+		},
+		{
+			Ints([]int{1, 5, 10, 11}),
+			[]float64{0, 5, 10},
+			nil,
+			Ints([]string{"0", "0", "1", NaN}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Cut(test.edges, test.labels)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if received.Type() != test.expected.Type() ||
+			!reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	errTests := []struct {
+		series Series
+		edges  []float64
+		labels []string
+	}{
+		{Floats([]float64{1}), []float64{0}, nil},
+		{Floats([]float64{1}), []float64{0, 2, 1}, nil},
+		{Floats([]float64{1}), []float64{0, 1, 2}, []string{"a"}},
+		{Strings([]string{"1"}), []float64{0, 1}, nil},
+		{Bools([]bool{true}), []float64{0, 1}, nil},
+		{Categoricals([]string{"1"}), []float64{0, 1}, nil},
+		{New([]int{1}, DateTime, ""), []float64{0, 1}, nil},
+	}
+	for testnum, test := range errTests {
+		if err := test.series.Cut(test.edges, test.labels).Error(); err == nil {
+			t.Errorf("Test-Error:%v\nExpected error", testnum)
+		}
+	}
+}
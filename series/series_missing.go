@@ -0,0 +1,227 @@
+package series
+
+import (
+	"fmt"
+	"math"
+)
+
+// DropNaN returns a new Series containing only the non-NaN elements of the series.
+func (s series) DropNaN() Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	return s.Filter(func(ele Element, index int) bool {
+		return !ele.IsNA()
+	})
+}
+
+// TrimNaN returns a new Series without the leading and trailing NaN elements of the series,
+// the NaN elements between the first and the last non-NaN element are kept.
+func (s series) TrimNaN() Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	first := s.FirstValidIndex()
+	if first < 0 {
+		return s.Empty()
+	}
+	return &series{
+		name:     s.name,
+		t:        s.t,
+		elements: s.elements.Slice(first, s.LastValidIndex()+1).Copy(),
+	}
+}
+
+// Coalesce returns a new Series where each NaN element is filled from the first non-NaN element
+// at the same index among others, in order. All the series must have the same length.
+func (s series) Coalesce(others ...Series) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	for _, other := range others {
+		var err error
+		if e := other.Error(); e != nil {
+			err = fmt.Errorf("argument has errors: %v", e)
+		} else if other.Len() != s.Len() {
+			err = fmt.Errorf("length mismatch (%d != %d)", s.Len(), other.Len())
+		}
+		if err != nil {
+			empty := s.Empty()
+			empty.SetErr(fmt.Errorf("coalesce error: %v", err))
+			return empty
+		}
+	}
+	elements := s.elements.Copy()
+	for i := 0; i < elements.Len(); i++ {
+		ele := elements.Elem(i)
+		if !ele.IsNA() {
+			continue
+		}
+		for _, other := range others {
+			if fallback := other.Elem(i); !fallback.IsNA() {
+				ele.SetElement(fallback)
+				break
+			}
+		}
+	}
+	return &series{
+		name:     s.name,
+		t:        s.t,
+		elements: elements,
+	}
+}
+
+// Interpolate fills NaN values by linear interpolation between the surrounding non-NaN values.
+// The leading and trailing NaN values are linearly extrapolated from the two nearest non-NaN values
+// when extrapolate is true, otherwise they are left as NaN.
+// The interpolated values of Int and Int64 series are rounded, series of non-numeric types are left unchanged.
+func (s series) Interpolate(extrapolate bool) {
+	if !s.t.numeric() {
+		return
+	}
+	var valid []int
+	for i := 0; i < s.Len(); i++ {
+		if !s.elements.Elem(i).IsNA() {
+			valid = append(valid, i)
+		}
+	}
+	if len(valid) == 0 {
+		return
+	}
+
+	fill := func(i, x0, x1 int) {
+		y0 := s.elements.Elem(x0).Float()
+		y1 := s.elements.Elem(x1).Float()
+		v := y0
+		if x1 != x0 {
+			v = y0 + (y1-y0)*float64(i-x0)/float64(x1-x0)
+		}
+		if s.t == Int || s.t == Int64 {
+			v = math.Round(v)
+		}
+		s.elements.Elem(i).SetFloat(v)
+	}
+
+	for k := 1; k < len(valid); k++ {
+		for i := valid[k-1] + 1; i < valid[k]; i++ {
+			fill(i, valid[k-1], valid[k])
+		}
+	}
+	if !extrapolate {
+		return
+	}
+	first, second := valid[0], valid[0]
+	if len(valid) > 1 {
+		second = valid[1]
+	}
+	for i := 0; i < first; i++ {
+		fill(i, first, second)
+	}
+	last, secondLast := valid[len(valid)-1], valid[len(valid)-1]
+	if len(valid) > 1 {
+		secondLast = valid[len(valid)-2]
+	}
+	for i := last + 1; i < s.Len(); i++ {
+		fill(i, secondLast, last)
+	}
+}
+
+// FillNaNForwardLimit fill NaN values using the last non-NaN value,
+// at most limit consecutive NaN values are filled.
+func (s series) FillNaNForwardLimit(limit int) {
+	var lastNotNaNValue ElementValue = nil
+	filled := 0
+	for i := 0; i < s.Len(); i++ {
+		ele := s.Elem(i)
+		if !ele.IsNA() {
+			lastNotNaNValue = ele.Val()
+			filled = 0
+		} else if lastNotNaNValue != nil && filled < limit {
+			ele.Set(lastNotNaNValue)
+			filled++
+		}
+	}
+}
+
+// FillNaNBackwardLimit fill NaN values using the next non-NaN value,
+// at most limit consecutive NaN values are filled.
+func (s series) FillNaNBackwardLimit(limit int) {
+	var lastNotNaNValue ElementValue = nil
+	filled := 0
+	for i := s.Len() - 1; i >= 0; i-- {
+		ele := s.Elem(i)
+		if !ele.IsNA() {
+			lastNotNaNValue = ele.Val()
+			filled = 0
+		} else if lastNotNaNValue != nil && filled < limit {
+			ele.Set(lastNotNaNValue)
+			filled++
+		}
+	}
+}
+
+// Replace replaces the elements equal to old by new, the Series is modified in place.
+// NaN elements are replaced when old is nil, NaN, a NaN float or a NaN Element.
+func (s series) Replace(old, new ElementValue) {
+	s.ReplaceMap(map[interface{}]interface{}{old: new})
+}
+
+// ReplaceMap replaces the elements equal to a key of m by the corresponding value,
+// the Series is modified in place.
+// NaN elements are replaced when a key is nil, NaN, a NaN float or a NaN Element.
+func (s series) ReplaceMap(m map[interface{}]interface{}) {
+	type replacement struct {
+		old Element
+		new interface{}
+	}
+	var naValue interface{}
+	hasNA := false
+	replacements := make([]replacement, 0, len(m))
+	for old, new := range m {
+		if isNaNValue(old) {
+			naValue = new
+			hasNA = true
+			continue
+		}
+		oldEle := s.t.emptyElements(1).Elem(0)
+		oldEle.Set(old)
+		if oldEle.IsNA() {
+			// old can not be converted to the type of the series, it never matches
+			continue
+		}
+		replacements = append(replacements, replacement{old: oldEle, new: new})
+	}
+
+	for i := 0; i < s.Len(); i++ {
+		ele := s.elements.Elem(i)
+		if ele.IsNA() {
+			if hasNA {
+				ele.Set(naValue)
+			}
+			continue
+		}
+		for _, r := range replacements {
+			if ele.Eq(r.old) {
+				ele.Set(r.new)
+				break
+			}
+		}
+	}
+}
+
+// isNaNValue returns whether the value represents a NaN element.
+func isNaNValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == NaN
+	case float64:
+		return math.IsNaN(v)
+	case float32:
+		return math.IsNaN(float64(v))
+	case Element:
+		return v.IsNA()
+	}
+	return false
+}
@@ -0,0 +1,320 @@
+package series
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeries_DropNaN(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected Series
+	}{
+		{
+			Floats([]string{NaN, "1.5", NaN, "-0.3"}),
+			Floats([]string{"1.5", "-0.3"}),
+		},
+		{
+			Ints([]string{"3", NaN, "7"}),
+			Ints([]string{"3", "7"}),
+		},
+		{
+			Strings([]string{NaN, NaN}),
+			Strings([]string{}),
+		},
+		{
+			Bools([]bool{true, false}),
+			Bools([]bool{true, false}),
+		},
+	}
+
+	for testnum, test := range tests {
+		test.series.SetName("name")
+		received := test.series.DropNaN()
+		if received.Type() != test.series.Type() || received.Name() != "name" {
+			t.Errorf(
+				"Test:%v\nExpected type %v and name %v, received %v and %v",
+				testnum, test.series.Type(), "name", received.Type(), received.Name(),
+			)
+		}
+		if !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_TrimNaN(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected Series
+	}{
+		{
+			Floats([]string{NaN, NaN, "1.5", NaN, "-0.3", NaN}),
+			Floats([]string{"1.5", NaN, "-0.3"}),
+		},
+		{
+			Ints([]string{"3", NaN, "7"}),
+			Ints([]string{"3", NaN, "7"}),
+		},
+		{
+			Strings([]string{NaN, NaN}),
+			Strings([]string{}),
+		},
+		{
+			Bools([]bool{}),
+			Bools([]bool{}),
+		},
+	}
+
+	for testnum, test := range tests {
+		test.series.SetName("name")
+		received := test.series.TrimNaN()
+		if received.Type() != test.series.Type() || received.Name() != "name" {
+			t.Errorf(
+				"Test:%v\nExpected type %v and name %v, received %v and %v",
+				testnum, test.series.Type(), "name", received.Type(), received.Name(),
+			)
+		}
+		if !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+		if received.Len() > 0 {
+			received.Elem(0).Set(NaN)
+			if test.series.Elem(test.series.FirstValidIndex()).IsNA() {
+				t.Errorf("Test:%v\nTrimNaN must not share elements with the series", testnum)
+			}
+		}
+	}
+}
+
+func TestSeries_Coalesce(t *testing.T) {
+	tests := []struct {
+		series   Series
+		others   []Series
+		expected Series
+	}{
+		{
+			Floats([]string{"1", NaN, NaN, NaN}),
+			[]Series{
+				Floats([]string{"9", "2", NaN, NaN}),
+				Ints([]string{"9", "9", "3", NaN}),
+			},
+			Floats([]string{"1", "2", "3", NaN}),
+		},
+		{
+			Strings([]string{NaN, "b"}),
+			[]Series{Ints([]int{1, 2})},
+			Strings([]string{"1", "b"}),
+		},
+		{
+			Ints([]string{NaN, "2"}),
+			nil,
+			Ints([]string{NaN, "2"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		before := test.series.Records()
+		received := test.series.Coalesce(test.others...)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if received.Type() != test.series.Type() || !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+		if !reflect.DeepEqual(before, test.series.Records()) {
+			t.Errorf("Test:%v\nCoalesce must not modify the series", testnum)
+		}
+	}
+
+	if err := Ints([]int{1, 2}).Coalesce(Ints([]int{1})).Error(); err == nil {
+		t.Errorf("Expected length mismatch error")
+	}
+}
+
+func TestSeries_Interpolate(t *testing.T) {
+	tests := []struct {
+		series      Series
+		extrapolate bool
+		expected    Series
+	}{
+		{
+			Floats([]string{NaN, "1", NaN, NaN, "4", NaN}),
+			false,
+			Floats([]string{NaN, "1", "2", "3", "4", NaN}),
+		},
+		{
+			Floats([]string{NaN, "1", NaN, NaN, "4", NaN}),
+			true,
+			Floats([]string{"0", "1", "2", "3", "4", "5"}),
+		},
+		{
+			Ints([]string{"1", NaN, "2", NaN, NaN, "-4"}),
+			false,
+			Ints([]string{"1", "2", "2", "0", "-2", "-4"}),
+		},
+		{
+			Int64s([]string{NaN, "1", NaN, "2", NaN}),
+			true,
+			Int64s([]string{"1", "1", "2", "2", "3"}),
+		},
+		{
+			Floats([]string{NaN, "7", NaN}),
+			true,
+			Floats([]string{"7", "7", "7"}),
+		},
+		{
+			Floats([]string{NaN, NaN}),
+			true,
+			Floats([]string{NaN, NaN}),
+		},
+		{
+			Strings([]string{"a", NaN, "c"}),
+			true,
+			Strings([]string{"a", NaN, "c"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		test.series.Interpolate(test.extrapolate)
+		expected := test.expected.Records()
+		received := test.series.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_FillNaNLimit(t *testing.T) {
+	tests := []struct {
+		series           Series
+		limit            int
+		forwardExpected  Series
+		backwardExpected Series
+	}{
+		{
+			Floats([]string{NaN, "1", NaN, NaN, NaN, "4", NaN}),
+			2,
+			Floats([]string{NaN, "1", "1", "1", NaN, "4", "4"}),
+			Floats([]string{"1", "1", NaN, "4", "4", "4", NaN}),
+		},
+		{
+			Ints([]string{"1", NaN, "2", NaN, NaN}),
+			1,
+			Ints([]string{"1", "1", "2", "2", NaN}),
+			Ints([]string{"1", "2", "2", NaN, NaN}),
+		},
+		{
+			Strings([]string{"a", NaN, "c"}),
+			0,
+			Strings([]string{"a", NaN, "c"}),
+			Strings([]string{"a", NaN, "c"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		s := test.series.Copy()
+		s.FillNaNForwardLimit(test.limit)
+		expected := test.forwardExpected.Records()
+		received := s.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-Forward:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+
+		s = test.series.Copy()
+		s.FillNaNBackwardLimit(test.limit)
+		expected = test.backwardExpected.Records()
+		received = s.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-Backward:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_Replace(t *testing.T) {
+	tests := []struct {
+		series   Series
+		old      ElementValue
+		new      ElementValue
+		expected Series
+	}{
+		{
+			Floats([]string{"-999", "1", "-999", NaN}),
+			-999,
+			nil,
+			Floats([]string{NaN, "1", NaN, NaN}),
+		},
+		{
+			Floats([]string{"-999", "1", NaN}),
+			math.NaN(),
+			0,
+			Floats([]string{"-999", "1", "0"}),
+		},
+		{
+			Ints([]string{"1", NaN, "2", "1"}),
+			"1",
+			5,
+			Ints([]string{"5", NaN, "2", "5"}),
+		},
+		{
+			Strings([]string{"a", NaN, "b"}),
+			NaN,
+			"z",
+			Strings([]string{"a", "z", "b"}),
+		},
+		{
+			Ints([]string{"1", NaN}),
+			"abc",
+			5,
+			Ints([]string{"1", NaN}),
+		},
+	}
+
+	for testnum, test := range tests {
+		test.series.Replace(test.old, test.new)
+		expected := test.expected.Records()
+		received := test.series.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_ReplaceMap(t *testing.T) {
+	s := Strings([]string{"a", "b", NaN, "c", "a"})
+	s.ReplaceMap(map[interface{}]interface{}{
+		"a": "b",
+		"b": "a",
+		nil: "n",
+	})
+	expected := Strings([]string{"b", "a", "n", "c", "b"}).Records()
+	received := s.Records()
+	if !reflect.DeepEqual(expected, received) {
+		t.Errorf(
+			"Test:\nExpected:\n%v\nReceived:\n%v",
+			expected, received,
+		)
+	}
+}
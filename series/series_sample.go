@@ -0,0 +1,52 @@
+package series
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Sample returns n randomly chosen elements of the series, with or without replacement.
+// The result is deterministic for a given seed.
+func (s series) Sample(n int, replace bool, seed int64) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	if n < 0 || (!replace && n > s.Len()) || (replace && n > 0 && s.Len() == 0) {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("sample error: can't take %d elements from a series of length %d", n, s.Len()))
+		return empty
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	var idx []int
+	if replace {
+		idx = make([]int, n)
+		for i := range idx {
+			idx[i] = r.Intn(s.Len())
+		}
+	} else {
+		idx = r.Perm(s.Len())[:n]
+	}
+	ret := s.Subset(idx)
+	ret.SetName(fmt.Sprintf("%s_Sample(%d)", s.name, n))
+	return ret
+}
+
+// Shuffle returns a randomly permuted copy of the series. The permutation is deterministic
+// for a given seed and length, so aligned series can be shuffled the same way.
+func (s series) Shuffle(seed int64) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	idx := make([]int, s.Len())
+	for i := range idx {
+		idx[i] = i
+	}
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(idx), func(i, j int) {
+		idx[i], idx[j] = idx[j], idx[i]
+	})
+	ret := s.Subset(idx)
+	ret.SetName(fmt.Sprintf("%s_Shuffle", s.name))
+	return ret
+}
@@ -0,0 +1,97 @@
+package series
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSeries_Sample(t *testing.T) {
+	tests := []struct {
+		series  Series
+		n       int
+		replace bool
+	}{
+		{
+			Ints([]int{1, 2, 3, 4, 5, 6}),
+			4,
+			false,
+		},
+		{
+			Strings([]string{"a", "b", "c"}),
+			3,
+			false,
+		},
+		{
+			Floats([]float64{1.5, 2.5}),
+			5,
+			true,
+		},
+		{
+			Ints([]int{}),
+			0,
+			false,
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Sample(test.n, test.replace, 42)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if received.Len() != test.n || received.Type() != test.series.Type() {
+			t.Errorf(
+				"Test:%v\nExpected %v elements of type %v\nReceived:\n%v",
+				testnum, test.n, test.series.Type(), received,
+			)
+		}
+		seen := map[string]bool{}
+		for _, r := range received.Records() {
+			if !test.replace && seen[r] {
+				t.Errorf("Test:%v\nDuplicated element %v without replacement", testnum, r)
+			}
+			seen[r] = true
+			if !test.series.Compare(Eq, r).Any() {
+				t.Errorf("Test:%v\nUnknown element %v", testnum, r)
+			}
+		}
+		again := test.series.Sample(test.n, test.replace, 42)
+		if !reflect.DeepEqual(received.Records(), again.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected same sample for the same seed:\n%v\nReceived:\n%v",
+				testnum, received, again,
+			)
+		}
+	}
+
+	if err := Ints([]int{1, 2}).Sample(3, false, 1).Error(); err == nil {
+		t.Errorf("Expected error when n > Len() without replacement")
+	}
+	if err := Ints([]int{1, 2}).Sample(-1, true, 1).Error(); err == nil {
+		t.Errorf("Expected error when n < 0")
+	}
+}
+
+func TestSeries_Shuffle(t *testing.T) {
+	ints := Ints([]int{0, 1, 2, 3, 4, 5, 6, 7})
+	strs := Strings([]string{"0", "1", "2", "3", "4", "5", "6", "7"})
+
+	shuffledInts := ints.Shuffle(7)
+	shuffledStrs := strs.Shuffle(7)
+	if !reflect.DeepEqual(shuffledInts.Records(), shuffledStrs.Records()) {
+		t.Errorf(
+			"Expected the same permutation for the same seed:\n%v\nReceived:\n%v",
+			shuffledInts, shuffledStrs,
+		)
+	}
+	sorted := shuffledInts.Subset(shuffledInts.Order(false))
+	if !reflect.DeepEqual(ints.Records(), sorted.Records()) {
+		t.Errorf("Expected a permutation of:\n%v\nReceived:\n%v", ints, shuffledInts)
+	}
+	if ints.Records()[0] != "0" {
+		t.Errorf("Expected the original series to be kept:\n%v", ints)
+	}
+
+	if received := Ints([]int{}).Shuffle(1); received.Len() != 0 {
+		t.Errorf("Expected empty series, received:\n%v", received)
+	}
+}
@@ -0,0 +1,541 @@
+package series
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/stat"
+)
+
+// Winsorize clips the values of the series to the lowerP and upperP empirical
+// quantiles computed from the non-NaN data and returning a new Series object.
+// Series of a type other than Int, Int64 and Float return an error.
+func (s series) Winsorize(lowerP, upperP float64) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	if !s.t.numeric() {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("winsorize error: series type %v is not numeric", s.t))
+		return empty
+	}
+	if lowerP >= upperP {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("winsorize error: lowerP must be less than upperP"))
+		return empty
+	}
+
+	valid := s.DropNaN()
+	if valid.Len() == 0 {
+		return s.Copy()
+	}
+	lower := valid.Quantile(lowerP)
+	upper := valid.Quantile(upperP)
+
+	ret := s.clip(lower, upper)
+	ret.SetName(fmt.Sprintf("%s_Winsorize(%v,%v)", s.name, lowerP, upperP))
+	return ret
+}
+
+// ClipSeries clips each value of the series to its per-row bounds [lower[i], upper[i]] and returning
+// a new Series object. A bound of length 1 is broadcast to all the elements, NaN bounds are ignored.
+// The bounds of Int and Int64 series are rounded inward, an element is NaN when no integer lies within its bounds.
+// Series of a type other than Int, Int64 and Float return an error.
+func (s series) ClipSeries(lower, upper Series) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	fail := func(err error) Series {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("clip error: %v", err))
+		return empty
+	}
+	if !s.t.numeric() {
+		return fail(fmt.Errorf("series type %v is not numeric", s.t))
+	}
+	if err := s.checkOperand(lower); err != nil {
+		return fail(err)
+	}
+	if err := s.checkOperand(upper); err != nil {
+		return fail(err)
+	}
+	lowers := s.operandFloats(lower)
+	uppers := s.operandFloats(upper)
+	ret := s.clipWith(func(index int) (float64, float64) {
+		return lowers[index], uppers[index]
+	})
+	ret.SetName(fmt.Sprintf("%s_Clip(%s,%s)", s.name, lower.Name(), upper.Name()))
+	return ret
+}
+
+// clip limits the values of the series to [lower, upper], NaN elements are kept.
+// The bounds of Int and Int64 series are rounded inward like in clipWith.
+func (s series) clip(lower, upper float64) Series {
+	return s.clipWith(func(index int) (float64, float64) {
+		return lower, upper
+	})
+}
+
+// clipWith limits the value at each index of the series to the bounds returned by bounds,
+// NaN elements are kept and NaN bounds are ignored. The bounds of Int and Int64 series are
+// rounded inward so the clipped values stay within them, an element is NaN when no integer does.
+func (s series) clipWith(bounds func(index int) (lower, upper float64)) Series {
+	integral := s.t == Int || s.t == Int64
+	return s.Map(func(e Element, index int) Element {
+		result := e.Copy()
+		if result.IsNA() {
+			return result
+		}
+		lower, upper := bounds(index)
+		if integral {
+			l, u := math.Ceil(lower), math.Floor(upper)
+			if l > u && lower <= upper {
+				result.SetNA()
+				return result
+			}
+			lower, upper = l, u
+		}
+		f := result.Float()
+		if f < lower {
+			result.Set(lower)
+		} else if f > upper {
+			result.Set(upper)
+		}
+		return result
+	})
+}
+
+// Count returns the number of non-NaN elements of the series.
+func (s series) Count() int {
+	count := 0
+	for _, notNaN := range s.IsNotNaN() {
+		if notNaN {
+			count++
+		}
+	}
+	return count
+}
+
+// NUnique returns the number of distinct non-NaN elements of the series.
+func (s series) NUnique() int {
+	return len(s.valueSet())
+}
+
+// ArgMax returns the index of the first biggest non-NaN element in the series,
+// or -1 if the series is empty, Complex or all elements are NaN.
+// Series of type String are compared lexicographically.
+func (s series) ArgMax() int {
+	return s.argBest(func(ele, best Element) bool {
+		return ele.Greater(best)
+	})
+}
+
+// ArgMin returns the index of the first lowest non-NaN element in the series,
+// or -1 if the series is empty, Complex or all elements are NaN.
+// Series of type String are compared lexicographically.
+func (s series) ArgMin() int {
+	return s.argBest(func(ele, best Element) bool {
+		return ele.Less(best)
+	})
+}
+
+// argBest returns the index of the first non-NaN element which is better than all the others.
+func (s series) argBest(better func(ele, best Element) bool) int {
+	bestIndex := -1
+	if s.t == Complex {
+		return bestIndex
+	}
+	var best Element
+	for i := 0; i < s.Len(); i++ {
+		ele := s.elements.Elem(i)
+		if ele.IsNA() {
+			continue
+		}
+		if best == nil || better(ele, best) {
+			best = ele
+			bestIndex = i
+		}
+	}
+	return bestIndex
+}
+
+// NLargest returns the n biggest non-NaN elements of the series sorted in descending order.
+// All the non-NaN elements are returned if n is larger than their number.
+// A Complex series returns an error as its elements are not ordered.
+func (s series) NLargest(n int) Series {
+	ret := s.firstOrdered(n, true)
+	ret.SetName(fmt.Sprintf("%s_NLargest(%d)", s.name, n))
+	return ret
+}
+
+// NSmallest returns the n lowest non-NaN elements of the series sorted in ascending order.
+// All the non-NaN elements are returned if n is larger than their number.
+// A Complex series returns an error as its elements are not ordered.
+func (s series) NSmallest(n int) Series {
+	ret := s.firstOrdered(n, false)
+	ret.SetName(fmt.Sprintf("%s_NSmallest(%d)", s.name, n))
+	return ret
+}
+
+// firstOrdered returns the first n non-NaN elements of the ordered series.
+func (s series) firstOrdered(n int, reverse bool) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	if s.t == Complex {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("series type %v is not ordered", s.t))
+		return empty
+	}
+	if n < 0 {
+		n = 0
+	}
+	if count := s.Count(); n > count {
+		n = count
+	}
+	return s.Subset(s.Order(reverse)[:n])
+}
+
+// SumSkipNaN calculates the sum value of the non-NaN elements of a series.
+// Returns NaN if there is no non-NaN element.
+func (s series) SumSkipNaN() float64 {
+	if s.Type() == String {
+		return math.NaN()
+	}
+	return s.DropNaN().Sum()
+}
+
+// MeanSkipNaN calculates the average value of the non-NaN elements of a series.
+// Returns NaN if there is no non-NaN element.
+func (s series) MeanSkipNaN() float64 {
+	valid := s.DropNaN()
+	if valid.Len() == 0 {
+		return math.NaN()
+	}
+	return valid.Mean()
+}
+
+// Cov returns the sample covariance between the series and other.
+// Pairs where either element is NaN are skipped.
+// Returns NaN if the lengths mismatch or any of the series is not numeric.
+func (s series) Cov(other Series) float64 {
+	x, y, ok := s.pairwiseFloats(other)
+	if !ok {
+		return math.NaN()
+	}
+	return stat.Covariance(x, y, nil)
+}
+
+// Corr returns the Pearson correlation between the series and other.
+// Pairs where either element is NaN are skipped.
+// Returns NaN if the lengths mismatch or any of the series is not numeric.
+func (s series) Corr(other Series) float64 {
+	x, y, ok := s.pairwiseFloats(other)
+	if !ok {
+		return math.NaN()
+	}
+	return stat.Correlation(x, y, nil)
+}
+
+// pairwiseFloats returns the float values of the series and other
+// where both elements at the same index are not NaN.
+func (s series) pairwiseFloats(other Series) (x, y []float64, ok bool) {
+	if s.Type() == String || other.Type() == String || s.Len() != other.Len() {
+		return nil, nil, false
+	}
+	for i := 0; i < s.Len(); i++ {
+		xe := s.elements.Elem(i)
+		ye := other.Elem(i)
+		if xe.IsNA() || ye.IsNA() {
+			continue
+		}
+		x = append(x, xe.Float())
+		y = append(y, ye.Float())
+	}
+	return x, y, true
+}
+
+// Dot returns the inner product of the series and other.
+// Returns NaN if the lengths mismatch or any of the series is not numeric.
+func (s series) Dot(other Series) float64 {
+	if s.Type() == String || other.Type() == String || s.Len() != other.Len() {
+		return math.NaN()
+	}
+	return floats.Dot(s.Float(), other.Float())
+}
+
+// Autocorr returns the Pearson correlation between the series and itself shifted by lag.
+// The NaN elements introduced by shifting are skipped pairwise.
+func (s series) Autocorr(lag int) float64 {
+	if lag >= s.Len() || -lag >= s.Len() {
+		return math.NaN()
+	}
+	return s.Corr(s.Shift(lag))
+}
+
+// Resample partitions the series into consecutive chunks of bucket elements and applies agg
+// to each of them, returning a Float Series. The final partial chunk is aggregated too.
+func (s series) Resample(bucket int, agg func(Series) float64) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	name := fmt.Sprintf("%s_Resample(%d)", s.name, bucket)
+	if bucket < 1 {
+		empty := New([]float64{}, Float, name)
+		empty.SetErr(fmt.Errorf("resample error: bucket must be greater than 0"))
+		return empty
+	}
+
+	n := (s.Len() + bucket - 1) / bucket
+	eles := make(floatElements, n)
+	for i := 0; i < n; i++ {
+		end := (i + 1) * bucket
+		if end > s.Len() {
+			end = s.Len()
+		}
+		eles[i].Set(agg(s.Slice(i*bucket, end)))
+	}
+	return &series{
+		name:     name,
+		elements: eles,
+		t:        Float,
+		err:      nil,
+	}
+}
+
+// ZScore standardizes the values of the series as (x - mean) / std, skipping NaN, and returns a Float Series.
+// All the non-NaN values are 0 if the standard deviation is 0 or undefined.
+func (s series) ZScore() Series {
+	valid := s.DropNaN()
+	mean := valid.Mean()
+	std := valid.StdDev()
+	return s.scale(fmt.Sprintf("ZScore(%s)", s.name), mean, std)
+}
+
+// MinMaxScale rescales the values of the series as (x - min) / (max - min), skipping NaN, and returns a Float Series.
+// All the non-NaN values are 0 if the range is 0.
+func (s series) MinMaxScale() Series {
+	valid := s.DropNaN()
+	min := valid.Min()
+	return s.scale(fmt.Sprintf("MinMaxScale(%s)", s.name), min, valid.Max()-min)
+}
+
+// scale maps the values of the series to (x - center) / width.
+func (s series) scale(name string, center, width float64) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	if s.t == String {
+		empty := New([]float64{}, Float, name)
+		empty.SetErr(fmt.Errorf("scale error: series type %v is not numeric", s.t))
+		return empty
+	}
+	degenerate := width == 0 || math.IsNaN(width)
+	ret := s.mapFloat(func(f float64) float64 {
+		if degenerate {
+			return 0
+		}
+		return (f - center) / width
+	})
+	ret.SetName(name)
+	return ret
+}
+
+// Skew calculates the sample skewness of a series.
+// Returns NaN for String and Bool series.
+func (s series) Skew() float64 {
+	if s.t == String || s.t == Bool || s.Len() == 0 {
+		return math.NaN()
+	}
+	return stat.Skew(s.Float(), nil)
+}
+
+// Kurtosis calculates the sample excess kurtosis of a series.
+// Returns NaN for String and Bool series.
+func (s series) Kurtosis() float64 {
+	if s.t == String || s.t == Bool || s.Len() == 0 {
+		return math.NaN()
+	}
+	return stat.ExKurtosis(s.Float(), nil)
+}
+
+// MeanAD calculates the mean absolute deviation about the mean of the non-NaN elements.
+// Returns NaN for String and Bool series.
+func (s series) MeanAD() float64 {
+	return s.absDeviation(func(x Series) float64 {
+		return x.Mean()
+	})
+}
+
+// MedianAD calculates the median absolute deviation about the median of the non-NaN elements.
+// Returns NaN for String and Bool series.
+func (s series) MedianAD() float64 {
+	return s.absDeviation(func(x Series) float64 {
+		return x.Median()
+	})
+}
+
+// absDeviation returns center of the absolute deviations of the non-NaN elements about their center.
+func (s series) absDeviation(center func(x Series) float64) float64 {
+	if s.t == String || s.t == Bool {
+		return math.NaN()
+	}
+	valid := s.DropNaN()
+	if valid.Len() == 0 {
+		return math.NaN()
+	}
+	c := center(valid)
+	deviations := valid.Float()
+	for i, f := range deviations {
+		deviations[i] = math.Abs(f - c)
+	}
+	return center(Floats(deviations))
+}
+
+// IQR returns the interquartile range Quantile(0.75) - Quantile(0.25) of the non-NaN elements.
+func (s series) IQR() float64 {
+	q1, q3 := s.quartiles()
+	return q3 - q1
+}
+
+// OutlierMask returns a Bool Series flagging the elements outside the Tukey fences
+// [Q1 - k*IQR, Q3 + k*IQR] of the non-NaN elements. NaN elements are not flagged.
+func (s series) OutlierMask(k float64) Series {
+	name := fmt.Sprintf("%s_OutlierMask(%v)", s.name, k)
+	if err := s.err; err != nil {
+		return &s
+	}
+	if s.t == String {
+		empty := New([]bool{}, Bool, name)
+		empty.SetErr(fmt.Errorf("outlier mask error: series type %v is not numeric", s.t))
+		return empty
+	}
+	q1, q3 := s.quartiles()
+	lower, upper := q1-k*(q3-q1), q3+k*(q3-q1)
+	bools := make([]bool, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		ele := s.elements.Elem(i)
+		if ele.IsNA() {
+			continue
+		}
+		f := ele.Float()
+		bools[i] = f < lower || f > upper
+	}
+	return New(bools, Bool, name)
+}
+
+// quartiles returns the first and third quartiles of the non-NaN elements.
+func (s series) quartiles() (q1, q3 float64) {
+	valid := s.DropNaN()
+	return valid.Quantile(0.25), valid.Quantile(0.75)
+}
+
+// Histogram returns the counts of the non-NaN elements in bins equal-width bins between
+// Min and Max, and the bins+1 edges of the bins. The bins are right-open except the last one.
+// If all the elements are equal they are counted in the first bin.
+// Returns nil if bins < 1, the series is not numeric or has no non-NaN element.
+func (s series) Histogram(bins int) (counts []int, edges []float64) {
+	if bins < 1 || s.t == String {
+		return nil, nil
+	}
+	valid := s.DropNaN()
+	if valid.Len() == 0 {
+		return nil, nil
+	}
+	min, max := valid.Min(), valid.Max()
+	width := (max - min) / float64(bins)
+	edges = make([]float64, bins+1)
+	for i := 0; i < bins; i++ {
+		edges[i] = min + float64(i)*width
+	}
+	edges[bins] = max
+	counts = make([]int, bins)
+	for _, f := range valid.Float() {
+		bin := 0
+		if width > 0 {
+			bin = int((f - min) / width)
+			if bin >= bins {
+				bin = bins - 1
+			}
+		}
+		counts[bin]++
+	}
+	return counts, edges
+}
+
+// WeightedQuantile is like Quantile but each element is weighted by the weight at the same index.
+// NaN elements are skipped. Returns NaN for a String or Complex series, if len(weights) != Len(), any weight is negative or p is not in [0, 1].
+func (s series) WeightedQuantile(p float64, weights []float64) float64 {
+	if s.Type() == String || s.Type() == Complex || len(weights) != s.Len() || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) {
+			return math.NaN()
+		}
+	}
+
+	var x, ws []float64
+	for _, i := range s.Order(false) {
+		ele := s.elements.Elem(i)
+		if ele.IsNA() {
+			break
+		}
+		x = append(x, ele.Float())
+		ws = append(ws, weights[i])
+	}
+	if len(x) == 0 || floats.Sum(ws) == 0 {
+		return math.NaN()
+	}
+	return stat.Quantile(p, stat.Empirical, x, ws)
+}
+
+// QuantileKind is the estimation method of QuantileWith.
+type QuantileKind string
+
+// Supported QuantileKinds
+const (
+	QuantileEmpirical QuantileKind = "empirical" // Inverse of the empirical CDF, used by Quantile
+	QuantileLinInterp QuantileKind = "lininterp" // Linear interpolation of the empirical CDF (R-4)
+	QuantileLinear    QuantileKind = "linear"    // Linear interpolation between the closest ranks (R-7, numpy default)
+)
+
+// QuantileWith is like Quantile but computed with the estimation method kind, skipping NaN.
+// Returns NaN for a String or Complex series, if p is not in [0, 1] or kind is unknown.
+func (s series) QuantileWith(p float64, kind QuantileKind) float64 {
+	if s.Type() == String || s.Type() == Complex || p < 0 || p > 1 {
+		return math.NaN()
+	}
+	valid := s.DropNaN()
+	if valid.Len() == 0 {
+		return math.NaN()
+	}
+	x := valid.Subset(valid.Order(false)).Float()
+
+	switch kind {
+	case QuantileEmpirical:
+		return stat.Quantile(p, stat.Empirical, x, nil)
+	case QuantileLinInterp:
+		return stat.Quantile(p, stat.LinInterp, x, nil)
+	case QuantileLinear:
+		h := float64(len(x)-1) * p
+		lo := math.Floor(h)
+		if int(lo) == len(x)-1 {
+			return x[len(x)-1]
+		}
+		return x[int(lo)] + (h-lo)*(x[int(lo)+1]-x[int(lo)])
+	default:
+		return math.NaN()
+	}
+}
+
+// SEM calculates the standard error of the mean StdDev() / sqrt(Count()) of the non-NaN elements.
+// Returns NaN for String series.
+func (s series) SEM() float64 {
+	if s.Type() == String {
+		return math.NaN()
+	}
+	valid := s.DropNaN()
+	return valid.StdDev() / math.Sqrt(float64(valid.Len()))
+}
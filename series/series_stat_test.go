@@ -0,0 +1,1025 @@
+package series
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeries_Winsorize(t *testing.T) {
+	tests := []struct {
+		series   Series
+		lowerP   float64
+		upperP   float64
+		expected Series
+	}{
+		{
+			Floats([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 100}),
+			0.1,
+			0.9,
+			Floats([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 9}),
+		},
+		{
+			Ints([]int{-50, 2, 3, 4, 5, 6, 7, 8, 9, 10}),
+			0.2,
+			1,
+			Ints([]int{2, 2, 3, 4, 5, 6, 7, 8, 9, 10}),
+		},
+		{
+			Floats([]string{NaN, "1", "2", "3", "40", NaN}),
+			0,
+			0.5,
+			Floats([]string{NaN, "1", "2", "2", "2", NaN}),
+		},
+		{
+			Floats([]string{NaN, NaN}),
+			0.1,
+			0.9,
+			Floats([]string{NaN, NaN}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Winsorize(test.lowerP, test.upperP)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	received := Floats([]float64{1, 2, 3}).Winsorize(0.9, 0.1)
+	if received.Error() == nil {
+		t.Errorf("Expected error when lowerP >= upperP")
+	}
+
+	for testnum, s := range []Series{
+		Strings([]string{"1"}),
+		Bools([]bool{true}),
+		Categoricals([]string{"1"}),
+		New([]int{1}, DateTime, ""),
+	} {
+		if err := s.Winsorize(0.1, 0.9).Error(); err == nil {
+			t.Errorf("Test-NotNumeric:%v\nExpected error for type %v", testnum, s.Type())
+		}
+	}
+
+	// the fractional bounds of an Int series are rounded inward
+	clipped := Ints([]string{"1", "2", "5", NaN}).(*series).clip(1.5, 4.5)
+	if expected := []string{"2", "2", "4", NaN}; clipped.Type() != Int || !reflect.DeepEqual(expected, clipped.Records()) {
+		t.Errorf("Test-Clip\nExpected:\n%v\nReceived:\n%v", expected, clipped)
+	}
+}
+
+func TestSeries_ClipSeries(t *testing.T) {
+	tests := []struct {
+		series   Series
+		lower    Series
+		upper    Series
+		expected Series
+	}{
+		{
+			Floats([]string{"-5", "1.5", "7", NaN, "3"}),
+			Floats([]string{"0", "2", "0", "0", NaN}),
+			Floats([]string{"1", "4", "6", "1", "2"}),
+			Floats([]string{"0", "2", "6", NaN, "2"}),
+		},
+		{
+			Ints([]int{1, 5, 9}),
+			Ints([]int{2}),
+			Ints([]int{3, 4, 10}),
+			Ints([]int{2, 4, 9}),
+		},
+		{
+			Ints([]string{"1", "5", "9", "2", NaN}),
+			Floats([]string{"1.5", "1.5", "1.5", "2.2", "1.5"}),
+			Floats([]string{"3.5", "3.5", "9.9", "2.8", "3.5"}),
+			Ints([]string{"2", "3", "9", NaN, NaN}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.ClipSeries(test.lower, test.upper)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if received.Type() != test.series.Type() || !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	received := Floats([]float64{1, 2, 3}).ClipSeries(Floats([]float64{0, 0}), Floats([]float64{1}))
+	if received.Error() == nil {
+		t.Errorf("Expected length mismatch error")
+	}
+
+	for testnum, s := range []Series{
+		Strings([]string{"1"}),
+		Bools([]bool{true}),
+		Categoricals([]string{"1"}),
+		New([]int{1}, DateTime, ""),
+	} {
+		if err := s.ClipSeries(Floats([]float64{0}), Floats([]float64{1})).Error(); err == nil {
+			t.Errorf("Test-NotNumeric:%v\nExpected error for type %v", testnum, s.Type())
+		}
+	}
+}
+
+func TestSeries_Count(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected int
+	}{
+		{
+			Floats([]string{"1.5", NaN, "0.3", NaN}),
+			2,
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			3,
+		},
+		{
+			Strings([]string{NaN, NaN}),
+			0,
+		},
+		{
+			Bools([]bool{}),
+			0,
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Count()
+		if received != test.expected {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+
+		cs := test.series.CacheAble()
+		_ = cs.Count()
+		received = cs.Count()
+		if received != test.expected {
+			t.Errorf(
+				"Test-Cache:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+		if _, found := cs.(*cacheAbleSeries).c.Get("Count"); !found {
+			t.Errorf("Test-Cache:%v\nExpected Count to be cached", testnum)
+		}
+	}
+}
+
+func TestSeries_NUnique(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected int
+	}{
+		{
+			Floats([]string{"1.5", NaN, "0.3", "1.5", NaN}),
+			2,
+		},
+		{
+			Ints([]int{3, 1, 2, 3, 1}),
+			3,
+		},
+		{
+			Strings([]string{"b", "a", NaN, "b", "c"}),
+			3,
+		},
+		{
+			Bools([]bool{true, true, false}),
+			2,
+		},
+		{
+			Strings([]string{NaN, NaN}),
+			0,
+		},
+		{
+			Complexes([]complex128{1 + 1i, 2, 1 + 1i}),
+			2,
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.NUnique()
+		if received != test.expected {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+
+		cs := test.series.CacheAble()
+		_ = cs.NUnique()
+		received = cs.NUnique()
+		if received != test.expected {
+			t.Errorf(
+				"Test-Cache:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_ArgMaxArgMin(t *testing.T) {
+	tests := []struct {
+		series         Series
+		argMaxExpected int
+		argMinExpected int
+	}{
+		{
+			Floats([]string{NaN, "1.5", "-0.3", "1.5", "-0.3"}),
+			1,
+			2,
+		},
+		{
+			Ints([]int{3, 1, 7, 3, 1}),
+			2,
+			1,
+		},
+		{
+			Strings([]string{"b", "a", NaN, "c", "a"}),
+			3,
+			1,
+		},
+		{
+			Bools([]bool{false, true, true}),
+			1,
+			0,
+		},
+		{
+			Floats([]string{NaN, NaN}),
+			-1,
+			-1,
+		},
+		{
+			Floats([]float64{}),
+			-1,
+			-1,
+		},
+		{
+			Complexes([]complex128{1, 5, 3}),
+			-1,
+			-1,
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.ArgMax()
+		if received != test.argMaxExpected {
+			t.Errorf(
+				"Test-ArgMax:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.argMaxExpected, received,
+			)
+		}
+		received = test.series.ArgMin()
+		if received != test.argMinExpected {
+			t.Errorf(
+				"Test-ArgMin:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.argMinExpected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_NLargestNSmallest(t *testing.T) {
+	tests := []struct {
+		series            Series
+		n                 int
+		nLargestExpected  Series
+		nSmallestExpected Series
+	}{
+		{
+			Floats([]string{NaN, "1.5", "-0.3", "4", "2"}),
+			2,
+			Floats([]string{"4", "2"}),
+			Floats([]string{"-0.3", "1.5"}),
+		},
+		{
+			Ints([]string{"3", NaN, "7", "1"}),
+			10,
+			Ints([]string{"7", "3", "1"}),
+			Ints([]string{"1", "3", "7"}),
+		},
+		{
+			Strings([]string{"b", "a", "c"}),
+			1,
+			Strings([]string{"c"}),
+			Strings([]string{"a"}),
+		},
+		{
+			Ints([]int{1, 2}),
+			0,
+			Ints([]int{}),
+			Ints([]int{}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.NLargest(test.n)
+		if !reflect.DeepEqual(test.nLargestExpected.Records(), received.Records()) {
+			t.Errorf(
+				"Test-NLargest:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.nLargestExpected, received,
+			)
+		}
+		received = test.series.NSmallest(test.n)
+		if !reflect.DeepEqual(test.nSmallestExpected.Records(), received.Records()) {
+			t.Errorf(
+				"Test-NSmallest:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.nSmallestExpected, received,
+			)
+		}
+	}
+
+	s := Complexes([]complex128{1, 5, 3})
+	for _, received := range []Series{s.NLargest(1), s.NSmallest(1)} {
+		if received.Error() == nil || received.Len() != 0 {
+			t.Errorf("Test-Complex\nExpected an error\nReceived:\n%v", received)
+		}
+	}
+}
+
+func TestSeries_ComplexNotOrdered(t *testing.T) {
+	s := Complexes([]complex128{1, 5, 3})
+	received := []float64{
+		s.Max(), s.Min(), s.Median(), s.Quantile(0.5),
+		s.QuantileWith(0.5, QuantileLinear), s.WeightedQuantile(0.5, []float64{1, 1, 1}),
+	}
+	for testnum, f := range received {
+		if !math.IsNaN(f) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, math.NaN(), f)
+		}
+	}
+	if qs := s.Quantiles(0.5); qs != nil {
+		t.Errorf("Test-Quantiles\nExpected:\n%v\nReceived:\n%v", nil, qs)
+	}
+	if order := s.Order(true); !reflect.DeepEqual(order, []int{0, 1, 2}) {
+		t.Errorf("Test-Order\nExpected:\n%v\nReceived:\n%v", []int{0, 1, 2}, order)
+	}
+}
+
+func TestSeries_SumMeanSkipNaN(t *testing.T) {
+	tests := []struct {
+		series       Series
+		sumExpected  float64
+		meanExpected float64
+	}{
+		{
+			Floats([]string{NaN, "1.5", NaN, "-0.5", "2"}),
+			3,
+			1,
+		},
+		{
+			Ints([]string{"3", NaN, "7"}),
+			10,
+			5,
+		},
+		{
+			Bools([]string{"true", NaN, "false", "true"}),
+			2,
+			0.666666,
+		},
+		{
+			Floats([]string{NaN, NaN}),
+			math.NaN(),
+			math.NaN(),
+		},
+		{
+			Strings([]string{"A", "B"}),
+			math.NaN(),
+			math.NaN(),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.SumSkipNaN()
+		if !compareFloats(received, test.sumExpected, 6) {
+			t.Errorf(
+				"Test-Sum:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.sumExpected, received,
+			)
+		}
+		received = test.series.MeanSkipNaN()
+		if !compareFloats(received, test.meanExpected, 6) {
+			t.Errorf(
+				"Test-Mean:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.meanExpected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_CovCorr(t *testing.T) {
+	tests := []struct {
+		series       Series
+		other        Series
+		covExpected  float64
+		corrExpected float64
+	}{
+		{
+			Floats([]float64{1, 2, 3, 4}),
+			Floats([]float64{2, 4, 6, 8}),
+			3.333333,
+			1,
+		},
+		{
+			Ints([]int{1, 2, 3, 4}),
+			Floats([]float64{4, 3, 2, 1}),
+			-1.666666,
+			-1,
+		},
+		{
+			Floats([]string{"1", NaN, "2", "3"}),
+			Floats([]string{"1", "100", "2", NaN}),
+			0.5,
+			1,
+		},
+		{
+			Floats([]float64{1, 2, 3}),
+			Floats([]float64{1, 2}),
+			math.NaN(),
+			math.NaN(),
+		},
+		{
+			Strings([]string{"A", "B"}),
+			Floats([]float64{1, 2}),
+			math.NaN(),
+			math.NaN(),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Cov(test.other)
+		if !compareFloats(received, test.covExpected, 6) {
+			t.Errorf(
+				"Test-Cov:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.covExpected, received,
+			)
+		}
+		received = test.series.Corr(test.other)
+		if !compareFloats(received, test.corrExpected, 6) {
+			t.Errorf(
+				"Test-Corr:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.corrExpected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_Dot(t *testing.T) {
+	tests := []struct {
+		series   Series
+		other    Series
+		expected float64
+	}{
+		{
+			Floats([]float64{1, 2, 3}),
+			Floats([]float64{4, 5, 6}),
+			32,
+		},
+		{
+			Ints([]int{1, 2}),
+			Bools([]bool{true, false}),
+			1,
+		},
+		{
+			Floats([]string{"1", NaN}),
+			Floats([]float64{1, 2}),
+			math.NaN(),
+		},
+		{
+			Floats([]float64{1, 2, 3}),
+			Floats([]float64{1, 2}),
+			math.NaN(),
+		},
+		{
+			Strings([]string{"1"}),
+			Floats([]float64{1}),
+			math.NaN(),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Dot(test.other)
+		if !compareFloats(received, test.expected, 6) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_Autocorr(t *testing.T) {
+	tests := []struct {
+		series   Series
+		lag      int
+		expected float64
+	}{
+		{
+			Floats([]float64{1, 2, 3, 4, 5}),
+			1,
+			1,
+		},
+		{
+			Ints([]int{1, -1, 1, -1, 1, -1}),
+			1,
+			-1,
+		},
+		{
+			Ints([]int{1, -1, 1, -1, 1, -1}),
+			-2,
+			1,
+		},
+		{
+			Floats([]float64{1, 2, 3}),
+			3,
+			math.NaN(),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Autocorr(test.lag)
+		if !compareFloats(received, test.expected, 6) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_Resample(t *testing.T) {
+	tests := []struct {
+		series   Series
+		bucket   int
+		agg      func(Series) float64
+		expected Series
+	}{
+		{
+			Floats([]float64{1, 2, 3, 4, 5, 6, 7}),
+			3,
+			func(s Series) float64 { return s.Sum() },
+			Floats([]float64{6, 15, 7}),
+		},
+		{
+			Ints([]int{1, 3, 5, 7}),
+			2,
+			func(s Series) float64 { return s.Mean() },
+			Floats([]float64{2, 6}),
+		},
+		{
+			Floats([]string{"1", NaN, "3"}),
+			2,
+			func(s Series) float64 { return s.MeanSkipNaN() },
+			Floats([]float64{1, 3}),
+		},
+		{
+			Floats([]float64{}),
+			2,
+			func(s Series) float64 { return s.Sum() },
+			Floats([]float64{}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Resample(test.bucket, test.agg)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	received := Floats([]float64{1, 2}).Resample(0, func(s Series) float64 { return s.Sum() })
+	if received.Error() == nil {
+		t.Errorf("Expected error when bucket < 1")
+	}
+}
+
+func TestSeries_ZScoreMinMaxScale(t *testing.T) {
+	tests := []struct {
+		series              Series
+		zScoreExpected      []float64
+		minMaxScaleExpected []float64
+	}{
+		{
+			Floats([]string{"1", NaN, "2", "3"}),
+			[]float64{-1, math.NaN(), 0, 1},
+			[]float64{0, math.NaN(), 0.5, 1},
+		},
+		{
+			Ints([]int{2, 4, 4, 4, 5, 5, 7, 9}),
+			[]float64{-1.4031215, -0.4677071, -0.4677071, -0.4677071, 0, 0, 0.9354143, 1.8708286},
+			[]float64{0, 0.285714, 0.285714, 0.285714, 0.428571, 0.428571, 0.714285, 1},
+		},
+		{
+			Floats([]string{"5", "5", NaN}),
+			[]float64{0, 0, math.NaN()},
+			[]float64{0, 0, math.NaN()},
+		},
+		{
+			Floats([]float64{5}),
+			[]float64{0},
+			[]float64{0},
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.ZScore().Float()
+		for i := range received {
+			if !compareFloats(received[i], test.zScoreExpected[i], 6) {
+				t.Errorf(
+					"Test-ZScore:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, test.zScoreExpected, received,
+				)
+				break
+			}
+		}
+		received = test.series.MinMaxScale().Float()
+		for i := range received {
+			if !compareFloats(received[i], test.minMaxScaleExpected[i], 6) {
+				t.Errorf(
+					"Test-MinMaxScale:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, test.minMaxScaleExpected, received,
+				)
+				break
+			}
+		}
+	}
+
+	if err := Strings([]string{"a"}).ZScore().Error(); err == nil {
+		t.Errorf("Expected error on String series")
+	}
+}
+
+func TestSeries_SkewKurtosis(t *testing.T) {
+	tests := []struct {
+		series           Series
+		skewExpected     float64
+		kurtosisExpected float64
+	}{
+		{
+			Floats([]float64{1, 2, 3, 4, 10}),
+			1.697056,
+			3.151999,
+		},
+		{
+			Ints([]int{1, 2, 3, 4, 5}),
+			0,
+			-1.199999,
+		},
+		{
+			Bools([]bool{true, false}),
+			math.NaN(),
+			math.NaN(),
+		},
+		{
+			Strings([]string{"1", "2"}),
+			math.NaN(),
+			math.NaN(),
+		},
+		{
+			Floats([]float64{}),
+			math.NaN(),
+			math.NaN(),
+		},
+	}
+
+	for testnum, test := range tests {
+		for _, s := range []Series{test.series, test.series.CacheAble()} {
+			received := s.Skew()
+			if !compareFloats(received, test.skewExpected, 5) {
+				t.Errorf(
+					"Test-Skew:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, test.skewExpected, received,
+				)
+			}
+			received = s.Kurtosis()
+			if !compareFloats(received, test.kurtosisExpected, 5) {
+				t.Errorf(
+					"Test-Kurtosis:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, test.kurtosisExpected, received,
+				)
+			}
+		}
+	}
+
+	cs := Floats([]float64{1, 2, 3, 4, 10}).CacheAble()
+	_ = cs.Skew()
+	_ = cs.Kurtosis()
+	for _, key := range []string{"Skew", "Kurtosis"} {
+		if _, found := cs.(*cacheAbleSeries).c.Get(key); !found {
+			t.Errorf("Expected %v to be cached", key)
+		}
+	}
+}
+
+func TestSeries_MeanADMedianAD(t *testing.T) {
+	tests := []struct {
+		series           Series
+		meanADExpected   float64
+		medianADExpected float64
+	}{
+		{
+			Floats([]string{"1", "2", NaN, "3", "4", "10"}),
+			2.4,
+			1,
+		},
+		{
+			Ints([]int{1, 1, 2, 2, 4, 6, 9}),
+			2.367346,
+			1,
+		},
+		{
+			Floats([]string{NaN, NaN}),
+			math.NaN(),
+			math.NaN(),
+		},
+		{
+			Bools([]bool{true, false}),
+			math.NaN(),
+			math.NaN(),
+		},
+		{
+			Strings([]string{"1", "2"}),
+			math.NaN(),
+			math.NaN(),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.MeanAD()
+		if !compareFloats(received, test.meanADExpected, 6) {
+			t.Errorf(
+				"Test-MeanAD:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.meanADExpected, received,
+			)
+		}
+		received = test.series.MedianAD()
+		if !compareFloats(received, test.medianADExpected, 6) {
+			t.Errorf(
+				"Test-MedianAD:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.medianADExpected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_IQROutlierMask(t *testing.T) {
+	tests := []struct {
+		series       Series
+		k            float64
+		iqrExpected  float64
+		maskExpected []bool
+	}{
+		{
+			Floats([]string{"1", "2", "3", "4", NaN, "5", "6", "7", "8", "100"}),
+			1.5,
+			4,
+			[]bool{false, false, false, false, false, false, false, false, false, true},
+		},
+		{
+			Ints([]int{-20, 1, 2, 3, 4}),
+			1,
+			2,
+			[]bool{true, false, false, false, false},
+		},
+		{
+			Floats([]string{NaN}),
+			1.5,
+			math.NaN(),
+			[]bool{false},
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.IQR()
+		if !compareFloats(received, test.iqrExpected, 6) {
+			t.Errorf(
+				"Test-IQR:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.iqrExpected, received,
+			)
+		}
+		mask, err := test.series.OutlierMask(test.k).Bool()
+		if err != nil || !reflect.DeepEqual(test.maskExpected, mask) {
+			t.Errorf(
+				"Test-OutlierMask:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.maskExpected, mask,
+			)
+		}
+	}
+
+	if err := Strings([]string{"a"}).OutlierMask(1.5).Error(); err == nil {
+		t.Errorf("Expected error on String series")
+	}
+}
+
+func TestSeries_Histogram(t *testing.T) {
+	tests := []struct {
+		series         Series
+		bins           int
+		countsExpected []int
+		edgesExpected  []float64
+	}{
+		{
+			Floats([]string{"1", "2", NaN, "2.5", "4", "5"}),
+			4,
+			[]int{1, 2, 0, 2},
+			[]float64{1, 2, 3, 4, 5},
+		},
+		{
+			Ints([]string{NaN, "3", "3", "3"}),
+			3,
+			[]int{3, 0, 0},
+			[]float64{3, 3, 3, 3},
+		},
+		{
+			Floats([]float64{7}),
+			1,
+			[]int{1},
+			[]float64{7, 7},
+		},
+		{
+			Floats([]float64{1, 2}),
+			0,
+			nil,
+			nil,
+		},
+		{
+			Floats([]string{NaN}),
+			2,
+			nil,
+			nil,
+		},
+		{
+			Strings([]string{"a", "b"}),
+			2,
+			nil,
+			nil,
+		},
+	}
+	for testnum, test := range tests {
+		counts, edges := test.series.Histogram(test.bins)
+		if !reflect.DeepEqual(test.countsExpected, counts) || !reflect.DeepEqual(test.edgesExpected, edges) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v %v\nReceived:\n%v %v",
+				testnum, test.countsExpected, test.edgesExpected, counts, edges,
+			)
+		}
+	}
+}
+
+func TestSeries_WeightedQuantile(t *testing.T) {
+	tests := []struct {
+		series   Series
+		p        float64
+		weights  []float64
+		expected float64
+	}{
+		{
+			Floats([]float64{1, 2, 3, 4}),
+			0.5,
+			[]float64{1, 1, 1, 1},
+			Floats([]float64{1, 2, 3, 4}).Quantile(0.5),
+		},
+		{
+			Floats([]float64{4, 1, 2, 3}),
+			0.5,
+			[]float64{10, 1, 1, 1},
+			4,
+		},
+		{
+			Ints([]string{"1", NaN, "3"}),
+			0.4,
+			[]float64{1, 100, 1},
+			1,
+		},
+		{
+			Floats([]float64{1, 2}),
+			0.5,
+			[]float64{1},
+			math.NaN(),
+		},
+		{
+			Floats([]float64{1, 2}),
+			0.5,
+			[]float64{1, -1},
+			math.NaN(),
+		},
+		{
+			Floats([]float64{1, 2}),
+			1.5,
+			[]float64{1, 1},
+			math.NaN(),
+		},
+		{
+			Strings([]string{"a"}),
+			0.5,
+			[]float64{1},
+			math.NaN(),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.WeightedQuantile(test.p, test.weights)
+		if !compareFloats(received, test.expected, 6) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_QuantileWith(t *testing.T) {
+	s := Floats([]string{"4", "1", NaN, "3", "2"})
+	tests := []struct {
+		p        float64
+		kind     QuantileKind
+		expected float64
+	}{
+		{0.5, QuantileEmpirical, 2},
+		{0.5, QuantileLinInterp, 2},
+		{0.5, QuantileLinear, 2.5},
+		{0.1, QuantileLinear, 1.3},
+		{0.9, QuantileLinear, 3.7},
+		{0, QuantileLinear, 1},
+		{1, QuantileLinear, 4},
+		{0.9, QuantileEmpirical, 4},
+		{0.9, QuantileLinInterp, 3.6},
+		{1.1, QuantileLinear, math.NaN()},
+		{0.5, QuantileKind("unknown"), math.NaN()},
+	}
+
+	for testnum, test := range tests {
+		received := s.QuantileWith(test.p, test.kind)
+		if !compareFloats(received, test.expected, 6) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	if received := Floats([]float64{1, 2, 3, 4}).QuantileWith(0.3, QuantileEmpirical); received != Floats([]float64{1, 2, 3, 4}).Quantile(0.3) {
+		t.Errorf("Expected QuantileEmpirical to match Quantile, received %v", received)
+	}
+}
+
+func TestSeries_SEM(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected float64
+	}{
+		{
+			Floats([]string{"2", "4", NaN, "4", "4", "5", "5", "7", "9"}),
+			0.755928,
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			0.577350,
+		},
+		{
+			Floats([]float64{1}),
+			math.NaN(),
+		},
+		{
+			Strings([]string{"1", "2"}),
+			math.NaN(),
+		},
+	}
+
+	for testnum, test := range tests {
+		for _, s := range []Series{test.series, test.series.CacheAble()} {
+			received := s.SEM()
+			if !compareFloats(received, test.expected, 6) {
+				t.Errorf(
+					"Test:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, test.expected, received,
+				)
+			}
+		}
+	}
+
+	cs := Floats([]float64{1, 2, 3}).CacheAble()
+	_ = cs.SEM()
+	if _, found := cs.(*cacheAbleSeries).c.Get("SEM"); !found {
+		t.Errorf("Expected SEM to be cached")
+	}
+}
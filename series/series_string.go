@@ -0,0 +1,145 @@
+package series
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToUpper returns a new Series of the same type with all the letters mapped to their upper case.
+func (s series) ToUpper() Series {
+	ret := s.mapString(strings.ToUpper)
+	ret.SetName(fmt.Sprintf("ToUpper(%s)", s.name))
+	return ret
+}
+
+// ToLower returns a new Series of the same type with all the letters mapped to their lower case.
+func (s series) ToLower() Series {
+	ret := s.mapString(strings.ToLower)
+	ret.SetName(fmt.Sprintf("ToLower(%s)", s.name))
+	return ret
+}
+
+// TrimSpace returns a new Series of the same type with all the leading and trailing white space removed.
+func (s series) TrimSpace() Series {
+	ret := s.mapString(strings.TrimSpace)
+	ret.SetName(fmt.Sprintf("TrimSpace(%s)", s.name))
+	return ret
+}
+
+// mapString applies the function f to the non-NaN elements of a String or Categorical series,
+// the result has the type of the series.
+func (s series) mapString(f func(string) string) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	if s.t != String && s.t != Categorical {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("series type %v is not String", s.t))
+		return empty
+	}
+	return s.Map(func(ele Element, index int) Element {
+		ret := ele.Copy()
+		if !ret.IsNA() {
+			ret.SetString(f(ret.String()))
+		}
+		return ret
+	})
+}
+
+// StrContains returns a Bool Series identifying which elements contain sub, NaN elements are false.
+func (s series) StrContains(sub string) Series {
+	ret := s.matchString(func(str string) bool {
+		return strings.Contains(str, sub)
+	})
+	ret.SetName(fmt.Sprintf("StrContains(%s)", s.name))
+	return ret
+}
+
+// StrHasPrefix returns a Bool Series identifying which elements begin with prefix, NaN elements are false.
+func (s series) StrHasPrefix(prefix string) Series {
+	ret := s.matchString(func(str string) bool {
+		return strings.HasPrefix(str, prefix)
+	})
+	ret.SetName(fmt.Sprintf("StrHasPrefix(%s)", s.name))
+	return ret
+}
+
+// StrHasSuffix returns a Bool Series identifying which elements end with suffix, NaN elements are false.
+func (s series) StrHasSuffix(suffix string) Series {
+	ret := s.matchString(func(str string) bool {
+		return strings.HasSuffix(str, suffix)
+	})
+	ret.SetName(fmt.Sprintf("StrHasSuffix(%s)", s.name))
+	return ret
+}
+
+// matchString applies the predicate f to the non-NaN elements of a String or Categorical series
+// and returns a Bool Series.
+func (s series) matchString(f func(string) bool) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	if s.t != String && s.t != Categorical {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("series type %v is not String", s.t))
+		return empty
+	}
+	bools := make([]bool, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		ele := s.elements.Elem(i)
+		bools[i] = !ele.IsNA() && f(ele.String())
+	}
+	return Bools(bools)
+}
+
+// StrSplit splits the elements of a String or Categorical series by sep and returns a String Series per field.
+// The number of fields is determined by the first non-NaN element, the elements with fewer fields
+// are padded with NaN and the last field of the elements with more fields holds the unsplit remainder.
+// NaN elements produce NaN in every field.
+func (s series) StrSplit(sep string) []Series {
+	if err := s.err; err != nil {
+		return []Series{&s}
+	}
+	if s.t != String && s.t != Categorical {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("series type %v is not String", s.t))
+		return []Series{empty}
+	}
+
+	n := 0
+	for i := 0; i < s.Len(); i++ {
+		ele := s.elements.Elem(i)
+		if !ele.IsNA() {
+			n = len(strings.Split(ele.String(), sep))
+			break
+		}
+	}
+	if n == 0 {
+		n = 1
+	}
+
+	fields := make([][]string, n)
+	for j := 0; j < n; j++ {
+		fields[j] = make([]string, s.Len())
+	}
+	for i := 0; i < s.Len(); i++ {
+		ele := s.elements.Elem(i)
+		var parts []string
+		if !ele.IsNA() {
+			parts = strings.SplitN(ele.String(), sep, n)
+		}
+		for j := 0; j < n; j++ {
+			if j < len(parts) {
+				fields[j][i] = parts[j]
+			} else {
+				fields[j][i] = NaN
+			}
+		}
+	}
+
+	ret := make([]Series, n)
+	for j := 0; j < n; j++ {
+		ret[j] = New(fields[j], String, fmt.Sprintf("%s_Split(%d)", s.name, j))
+	}
+	return ret
+}
@@ -0,0 +1,176 @@
+package series
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSeries_StringMap(t *testing.T) {
+	tests := []struct {
+		series            Series
+		toUpperExpected   Series
+		toLowerExpected   Series
+		trimSpaceExpected Series
+	}{
+		{
+			Strings([]string{" aB ", NaN, "c\t", ""}),
+			Strings([]string{" AB ", NaN, "C\t", ""}),
+			Strings([]string{" ab ", NaN, "c\t", ""}),
+			Strings([]string{"aB", NaN, "c", ""}),
+		},
+		{
+			Categoricals([]string{" aB ", NaN, "c\t", " aB "}),
+			Categoricals([]string{" AB ", NaN, "C\t", " AB "}),
+			Categoricals([]string{" ab ", NaN, "c\t", " ab "}),
+			Categoricals([]string{"aB", NaN, "c", "aB"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.toUpperExpected.Records()
+		received := test.series.ToUpper().Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-ToUpper:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		expected = test.toLowerExpected.Records()
+		received = test.series.ToLower().Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-ToLower:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		expected = test.trimSpaceExpected.Records()
+		received = test.series.TrimSpace().Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-TrimSpace:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+
+	if err := Ints([]int{1}).ToUpper().Error(); err == nil {
+		t.Errorf("Expected error on non-String series")
+	}
+}
+
+func TestSeries_StringMatch(t *testing.T) {
+	tests := []struct {
+		series           Series
+		arg              string
+		containsExpected Series
+		prefixExpected   Series
+		suffixExpected   Series
+	}{
+		{
+			Strings([]string{"abc", NaN, "cab", "b", ""}),
+			"ab",
+			Bools([]bool{true, false, true, false, false}),
+			Bools([]bool{true, false, false, false, false}),
+			Bools([]bool{false, false, true, false, false}),
+		},
+		{
+			Categoricals([]string{"abc", NaN, "cab", "abc"}),
+			"ab",
+			Bools([]bool{true, false, true, true}),
+			Bools([]bool{true, false, false, true}),
+			Bools([]bool{false, false, true, false}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.containsExpected.Records()
+		received := test.series.StrContains(test.arg).Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-StrContains:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		expected = test.prefixExpected.Records()
+		received = test.series.StrHasPrefix(test.arg).Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-StrHasPrefix:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		expected = test.suffixExpected.Records()
+		received = test.series.StrHasSuffix(test.arg).Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-StrHasSuffix:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+
+	if err := Floats([]float64{1}).StrContains("1").Error(); err == nil {
+		t.Errorf("Expected error on non-String series")
+	}
+
+	s := Strings([]string{"a"})
+	s.SetName("s")
+	for expected, received := range map[string]Series{
+		"StrContains(s)":  s.StrContains("a"),
+		"StrHasPrefix(s)": s.StrHasPrefix("a"),
+		"StrHasSuffix(s)": s.StrHasSuffix("a"),
+	} {
+		if received.Name() != expected {
+			t.Errorf("Test-Name\nExpected:\n%v\nReceived:\n%v", expected, received.Name())
+		}
+	}
+}
+
+func TestSeries_StrSplit(t *testing.T) {
+	tests := []struct {
+		series   Series
+		sep      string
+		expected []Series
+	}{
+		{
+			Strings([]string{NaN, "a,b,c", "d,e", "f,g,h,i", ""}),
+			",",
+			[]Series{
+				Strings([]string{NaN, "a", "d", "f", ""}),
+				Strings([]string{NaN, "b", "e", "g", NaN}),
+				Strings([]string{NaN, "c", NaN, "h,i", NaN}),
+			},
+		},
+		{
+			Strings([]string{"a", "b"}),
+			",",
+			[]Series{
+				Strings([]string{"a", "b"}),
+			},
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.StrSplit(test.sep)
+		if len(received) != len(test.expected) {
+			t.Errorf(
+				"Test:%v\nExpected %v fields, received %v",
+				testnum, len(test.expected), len(received),
+			)
+			continue
+		}
+		for j := range received {
+			expected := test.expected[j].Records()
+			if !reflect.DeepEqual(expected, received[j].Records()) {
+				t.Errorf(
+					"Test:%v field %v\nExpected:\n%v\nReceived:\n%v",
+					testnum, j, expected, received[j].Records(),
+				)
+			}
+		}
+	}
+
+	if err := Ints([]int{1}).StrSplit(",")[0].Error(); err == nil {
+		t.Errorf("Expected error on non-String series")
+	}
+}
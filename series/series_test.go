@@ -3,10 +3,14 @@ package series
 import (
 	"fmt"
 	"math"
+	"math/cmplx"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // Check that there are no shared memory addreses between the elements of two Series
@@ -395,6 +399,24 @@ func TestSeries_Compare(t *testing.T) {
 			[]bool{false, false, false},
 			Bools([]bool{false, false, true}),
 		},
+		{
+			Strings([]string{"Hello", "world", "this", NaN, "a", "test"}),
+			NotIn,
+			[]string{"cat", "world", "hello", "a"},
+			Bools([]bool{true, false, true, true, false, true}),
+		},
+		{
+			Ints([]int{0, 2, 1, 5, 9}),
+			NotIn,
+			[]int{2, 99, 1234, 9},
+			Bools([]bool{true, false, true, true, false}),
+		},
+		{
+			Floats([]float64{0.1, 2, 1, 5, 9}),
+			NotIn,
+			"2",
+			Bools([]bool{true, false, true, true, true}),
+		},
 	}
 	for testnum, test := range table {
 		a := test.series
@@ -492,6 +514,103 @@ func TestSeries_Compare_CompFunc(t *testing.T) {
 	}
 }
 
+func TestSeries_Compare_Regexp(t *testing.T) {
+	table := []struct {
+		series     Series
+		comparando interface{}
+		expected   Series
+	}{
+		{
+			Strings([]string{"A", "B", "C", NaN, "BADA", "ab"}),
+			"^B",
+			Bools([]bool{false, true, false, false, true, false}),
+		},
+		{
+			Strings([]string{"A", "B", "C", NaN, "BADA", "ab"}),
+			regexp.MustCompile("(?i)a"),
+			Bools([]bool{true, false, false, false, true, true}),
+		},
+		{
+			Ints([]int{10, 2, 100}),
+			"^1",
+			Bools([]bool{true, false, true}),
+		},
+	}
+	for testnum, test := range table {
+		b := test.series.Compare(Regexp, test.comparando)
+		if err := b.Error(); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		expected := test.expected.Records()
+		received := b.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+
+	b := Strings([]string{"A"}).Compare(Regexp, "(")
+	if b.Error() == nil {
+		t.Errorf("Expected error on invalid pattern")
+	}
+}
+
+func TestSeries_IsIn(t *testing.T) {
+	table := []struct {
+		series   Series
+		values   interface{}
+		set      map[interface{}]bool
+		expected Series
+	}{
+		{
+			Strings([]string{"A", "B", "C", NaN, "B"}),
+			[]string{"B", "C", NaN},
+			map[interface{}]bool{"B": true, "C": true, NaN: true},
+			Bools([]bool{false, true, true, false, true}),
+		},
+		{
+			Ints([]string{"1", "2", NaN, "4"}),
+			[]int{4, 1},
+			map[interface{}]bool{4: true, "1": true, 2: false},
+			Bools([]bool{true, false, false, true}),
+		},
+		{
+			Floats([]float64{1, 2.5, math.NaN(), 0}),
+			Floats([]float64{2.5, math.NaN(), 0}),
+			map[interface{}]bool{2.5: true, math.NaN(): true, 0: true},
+			Bools([]bool{false, true, false, true}),
+		},
+		{
+			Bools([]bool{true, false}),
+			[]bool{false},
+			map[interface{}]bool{false: true},
+			Bools([]bool{false, true}),
+		},
+		{
+			Ints([]int{1, 2}),
+			[]int{},
+			map[interface{}]bool{},
+			Bools([]bool{false, false}),
+		},
+	}
+	for testnum, test := range table {
+		expected := test.expected.Records()
+		for _, received := range []Series{test.series.IsIn(test.values), test.series.IsInSet(test.set)} {
+			if err := received.Error(); err != nil {
+				t.Errorf("Test:%v\nError:%v", testnum, err)
+			}
+			if !reflect.DeepEqual(expected, received.Records()) {
+				t.Errorf(
+					"Test:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received.Records(),
+				)
+			}
+		}
+	}
+}
+
 func TestSeries_Subset(t *testing.T) {
 	table := []struct {
 		series   Series
@@ -560,6 +679,56 @@ func TestSeries_Subset(t *testing.T) {
 	}
 }
 
+func TestSeries_Subset_OutOfBounds(t *testing.T) {
+	table := []Indexes{
+		5,
+		-1,
+		[]int{0, 3},
+		[]bool{true, false, true, true},
+		Ints([]int{0, 10}),
+		Bools([]bool{true}),
+	}
+	for testnum, indexes := range table {
+		a := Strings([]string{"A", "B", "C"})
+		if err := a.Subset(indexes).Error(); err == nil {
+			t.Errorf("Test-Subset:%v\nExpected error for indexes %v", testnum, indexes)
+		}
+		if err := a.Set(indexes, Strings("Z")).Error(); err == nil {
+			t.Errorf("Test-Set:%v\nExpected error for indexes %v", testnum, indexes)
+		}
+	}
+}
+
+func TestSeries_Reverse(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected Series
+	}{
+		{
+			Ints([]string{"1", NaN, "3"}),
+			Ints([]string{"3", NaN, "1"}),
+		},
+		{
+			Strings([]string{"a", "b", "c", "d"}),
+			Strings([]string{"d", "c", "b", "a"}),
+		},
+		{
+			Floats([]float64{}),
+			Floats([]float64{}),
+		},
+	}
+	for testnum, test := range tests {
+		expected := test.expected.Records()
+		received := test.series.Reverse().Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
 func TestSeries_Set(t *testing.T) {
 	table := []struct {
 		series   Series
@@ -713,6 +882,71 @@ func TestStrings(t *testing.T) {
 	}
 }
 
+func TestNewStrict(t *testing.T) {
+	table := []struct {
+		values   interface{}
+		t        Type
+		expected []string
+		failed   string
+	}{
+		{
+			[]string{"1.5", NaN, "3"},
+			Float,
+			[]string{"1.500000", NaN, "3.000000"},
+			"",
+		},
+		{
+			[]string{"1.5", "abc", "3", "x"},
+			Float,
+			nil,
+			"[1 3]",
+		},
+		{
+			[]interface{}{1, nil, "2", math.NaN(), "b"},
+			Int,
+			nil,
+			"[4]",
+		},
+		{
+			Strings([]string{"true", NaN, "maybe"}),
+			Bool,
+			nil,
+			"[2]",
+		},
+		{
+			"yes",
+			Bool,
+			nil,
+			"[0]",
+		},
+		{
+			nil,
+			Int,
+			[]string{NaN},
+			"",
+		},
+	}
+	for testnum, test := range table {
+		received, err := NewStrict(test.values, test.t, "name")
+		if test.failed != "" {
+			if err == nil || !strings.Contains(err.Error(), test.failed) {
+				t.Errorf("Test:%v\nExpected error with indexes %v, received %v", testnum, test.failed, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.expected, received.Records()) || received.Type() != test.t || received.Name() != "name" {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
 func TestInts(t *testing.T) {
 	table := []struct {
 		series   Series
@@ -1005,124 +1239,680 @@ func TestBools(t *testing.T) {
 	}
 }
 
-func TestSeries_Copy(t *testing.T) {
-	tests := []Series{
-		Strings([]string{"1", "2", "3", "a", "b", "c"}),
-		Ints([]string{"1", "2", "3", "a", "b", "c"}),
-		Floats([]string{"1", "2", "3", "a", "b", "c"}),
-		Bools([]string{"1", "0", "1", "t", "f", "c"}),
-	}
-	for testnum, test := range tests {
-		a := test
-		b := a.Copy()
-		if fmt.Sprint(a) != fmt.Sprint(b) {
-			t.Error("Different values when copying String elements")
-		}
-		if err := b.Error(); err != nil {
-			t.Errorf("Test:%v\nError:%v", testnum, err)
-		}
-		if err := checkTypes(b); err != nil {
-			t.Errorf("Test:%v\nError:%v", testnum, err)
-		}
-		//if err := checkAddr(a.Addr(), b.Addr()); err != nil {
-		//t.Errorf("Test:%v\nError:%v\nA:%v\nB:%v", testnum, err, a.Addr(), b.Addr())
-		//}
-	}
-}
-
-func TestSeries_Records(t *testing.T) {
-	tests := []struct {
+func TestTimes(t *testing.T) {
+	t0 := time.Date(2021, 3, 1, 12, 30, 0, 0, time.UTC)
+	t1 := time.Date(2021, 3, 2, 8, 0, 0, 500, time.FixedZone("CET", 3600))
+	table := []struct {
 		series   Series
-		expected []string
+		expected string
 	}{
 		{
-			Strings([]string{"1", "2", "3", "a", "b", "c"}),
-			[]string{"1", "2", "3", "a", "b", "c"},
+			Times([]time.Time{t0, t1}),
+			"[2021-03-01T12:30:00Z 2021-03-02T07:00:00.0000005Z]",
 		},
 		{
-			Ints([]string{"1", "2", "3", "a", "b", "c"}),
-			[]string{"1", "2", "3", "NaN", "NaN", "NaN"},
+			Times([]time.Time{}),
+			"[]",
 		},
 		{
-			Floats([]string{"1", "2", "3", "a", "b", "c"}),
-			[]string{"1.000000", "2.000000", "3.000000", "NaN", "NaN", "NaN"},
+			New([]string{"2021-03-01T12:30:00Z", "A", NaN}, DateTime, ""),
+			"[2021-03-01T12:30:00Z NaN NaN]",
 		},
 		{
-			Bools([]string{"1", "0", "1", "t", "f", "c"}),
-			[]string{"true", "false", "true", "true", "false", "NaN"},
+			New(t0, DateTime, ""),
+			"[2021-03-01T12:30:00Z]",
+		},
+		{
+			New([]int{0}, DateTime, ""),
+			"[1970-01-01T00:00:00Z]",
+		},
+		{
+			New(Strings([]string{"2021-03-01T13:30:00+01:00"}), DateTime, ""),
+			"[2021-03-01T12:30:00Z]",
 		},
 	}
-	for testnum, test := range tests {
+	for testnum, test := range table {
+		if err := test.series.Error(); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
 		expected := test.expected
-		received := test.series.Records()
-		if !reflect.DeepEqual(expected, received) {
+		received := fmt.Sprint(test.series)
+		if expected != received {
 			t.Errorf(
 				"Test:%v\nExpected:\n%v\nReceived:\n%v",
 				testnum, expected, received,
 			)
 		}
+		if err := checkTypes(test.series); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
 	}
 }
 
-func TestSeries_Float(t *testing.T) {
-	precision := 0.0000001
-	floatEquals := func(x, y []float64) bool {
-		if len(x) != len(y) {
-			return false
-		}
-		for i := 0; i < len(x); i++ {
-			a := x[i]
-			b := y[i]
-			if (a-b) > precision || (b-a) > precision {
-				return false
-			}
-		}
-		return true
+func TestSeries_DateTime(t *testing.T) {
+	t0 := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	t2 := t0.Add(-time.Hour)
+	s := New([]interface{}{t0, nil, t1, t2}, DateTime, "")
+
+	expectedOrder := []int{3, 0, 2, 1}
+	if received := s.Order(false); !reflect.DeepEqual(expectedOrder, received) {
+		t.Errorf("Test-Order\nExpected:\n%v\nReceived:\n%v", expectedOrder, received)
 	}
-	tests := []struct {
+
+	expectedCompare := []bool{true, false, true, false}
+	received, err := s.Compare(GreaterEq, t0).Bool()
+	if err != nil || !reflect.DeepEqual(expectedCompare, received) {
+		t.Errorf("Test-Compare\nExpected:\n%v\nReceived:\n%v", expectedCompare, received)
+	}
+	received, err = s.Compare(In, []string{"2021-03-01T01:00:00Z"}).Bool()
+	expectedCompare = []bool{false, false, true, false}
+	if err != nil || !reflect.DeepEqual(expectedCompare, received) {
+		t.Errorf("Test-Compare-In\nExpected:\n%v\nReceived:\n%v", expectedCompare, received)
+	}
+
+	valid := s.DropNaN()
+	if max := valid.Max(); max != float64(t1.UnixNano()) {
+		t.Errorf("Test-Max\nExpected:\n%v\nReceived:\n%v", t1.UnixNano(), max)
+	}
+	if min := valid.Min(); min != float64(t2.UnixNano()) {
+		t.Errorf("Test-Min\nExpected:\n%v\nReceived:\n%v", t2.UnixNano(), min)
+	}
+
+	expectedTimes := []time.Time{t0, {}, t1, t2}
+	if received := s.ToSlice(); !reflect.DeepEqual(expectedTimes, received) {
+		t.Errorf("Test-ToSlice\nExpected:\n%v\nReceived:\n%v", expectedTimes, received)
+	}
+}
+
+func TestInt64s(t *testing.T) {
+	table := []struct {
 		series   Series
-		expected []float64
+		expected string
 	}{
 		{
-			Strings([]string{"1", "2", "3", "a", "b", "c"}),
-			[]float64{1, 2, 3, math.NaN(), math.NaN(), math.NaN()},
+			Int64s([]int64{math.MaxInt64, math.MinInt64, 0}),
+			"[9223372036854775807 -9223372036854775808 0]",
 		},
 		{
-			Ints([]string{"1", "2", "3", "a", "b", "c"}),
-			[]float64{1, 2, 3, math.NaN(), math.NaN(), math.NaN()},
+			Int64s([]string{"9007199254740993", "A", NaN, "1.5"}),
+			"[9007199254740993 NaN NaN NaN]",
 		},
 		{
-			Floats([]string{"1", "2", "3", "a", "b", "c"}),
-			[]float64{1, 2, 3, math.NaN(), math.NaN(), math.NaN()},
+			Int64s([]interface{}{1, int64(2), 3.9, true, nil}),
+			"[1 2 3 1 NaN]",
 		},
 		{
-			Bools([]string{"1", "0", "1", "t", "f", "c"}),
-			[]float64{1, 0, 1, 1, 0, math.NaN()},
+			New(Ints([]int{7, 8}), Int64, ""),
+			"[7 8]",
+		},
+		{
+			Int64s([]int64{}),
+			"[]",
 		},
 	}
-	for testnum, test := range tests {
+	for testnum, test := range table {
+		if err := test.series.Error(); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
 		expected := test.expected
-		received := test.series.Float()
-		if !floatEquals(expected, received) {
+		received := fmt.Sprint(test.series)
+		if expected != received {
 			t.Errorf(
 				"Test:%v\nExpected:\n%v\nReceived:\n%v",
 				testnum, expected, received,
 			)
 		}
+		if err := checkTypes(test.series); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
 	}
 }
 
-func TestSeries_Concat(t *testing.T) {
-	tests := []struct {
-		a        Series
-		b        Series
-		expected []string
-	}{
-		{
-			Strings([]string{"1", "2", "3"}),
-			Strings([]string{"a", "b", "c"}),
-			[]string{"1", "2", "3", "a", "b", "c"},
-		},
+func TestSeries_Int64(t *testing.T) {
+	// 2^53 + 1 and 2^53 are the same float64
+	big := int64(1) << 53
+	s := Int64s([]interface{}{big + 1, nil, big, int64(-1)})
+
+	expectedOrder := []int{3, 2, 0, 1}
+	if received := s.Order(false); !reflect.DeepEqual(expectedOrder, received) {
+		t.Errorf("Test-Order\nExpected:\n%v\nReceived:\n%v", expectedOrder, received)
+	}
+
+	expectedCompare := []bool{true, false, false, false}
+	received, err := s.Compare(Greater, big).Bool()
+	if err != nil || !reflect.DeepEqual(expectedCompare, received) {
+		t.Errorf("Test-Compare\nExpected:\n%v\nReceived:\n%v", expectedCompare, received)
+	}
+	received, err = s.Compare(In, []string{"9007199254740993"}).Bool()
+	if err != nil || !reflect.DeepEqual(expectedCompare, received) {
+		t.Errorf("Test-Compare-In\nExpected:\n%v\nReceived:\n%v", expectedCompare, received)
+	}
+
+	expectedRecords := []string{"9007199254740993", NaN, "9007199254740992", "-1"}
+	if received := s.Records(); !reflect.DeepEqual(expectedRecords, received) {
+		t.Errorf("Test-Records\nExpected:\n%v\nReceived:\n%v", expectedRecords, received)
+	}
+
+	expectedSlice := []int64{big + 1, 0, big, -1}
+	if received := s.ToSlice(); !reflect.DeepEqual(expectedSlice, received) {
+		t.Errorf("Test-ToSlice\nExpected:\n%v\nReceived:\n%v", expectedSlice, received)
+	}
+
+	if received := s.As(String).As(Int64); !reflect.DeepEqual(s.Records(), received.Records()) {
+		t.Errorf("Test-As\nExpected:\n%v\nReceived:\n%v", s, received)
+	}
+}
+
+func TestComplexes(t *testing.T) {
+	table := []struct {
+		series   Series
+		expected string
+	}{
+		{
+			Complexes([]complex128{1 + 2i, -0.5i, 3}),
+			"[(1+2i) (0-0.5i) (3+0i)]",
+		},
+		{
+			Complexes([]string{"(1+2i)", "3-1i", "4", "A", NaN}),
+			"[(1+2i) (3-1i) (4+0i) NaN NaN]",
+		},
+		{
+			Complexes([]interface{}{1, 2.5, complex64(1i), true, nil}),
+			"[(1+0i) (2.5+0i) (0+1i) (1+0i) NaN]",
+		},
+		{
+			New(Floats([]string{"1.5", NaN}), Complex, ""),
+			"[(1.5+0i) NaN]",
+		},
+	}
+	for testnum, test := range table {
+		if err := test.series.Error(); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		expected := test.expected
+		received := fmt.Sprint(test.series)
+		if expected != received {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		if err := checkTypes(test.series); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+	}
+}
+
+func TestSeries_Complex(t *testing.T) {
+	s := Complexes([]interface{}{1 + 2i, nil, 3 - 4i})
+	c := Complexes([]complex128{1i})
+
+	tests := []struct {
+		received Series
+		expected []string
+	}{
+		{s.Add(c), []string{"(1+3i)", NaN, "(3-3i)"}},
+		{s.Sub(c), []string{"(1+1i)", NaN, "(3-5i)"}},
+		{s.Mul(c), []string{"(-2+1i)", NaN, "(4+3i)"}},
+		{s.Div(Complexes([]complex128{2, 2, 2})), []string{"(0.5+1i)", NaN, "(1.5-2i)"}},
+		{Floats([]float64{1, 2, 3}).Add(c), []string{"(1+1i)", "(2+1i)", "(3+1i)"}},
+	}
+	for testnum, test := range tests {
+		if err := test.received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if test.received.Type() != Complex || !reflect.DeepEqual(test.expected, test.received.Records()) {
+			t.Errorf("Test:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.expected, test.received)
+		}
+	}
+
+	abs := s.Abs()
+	if expected := []string{"2.236068", NaN, "5.000000"}; abs.Type() != Float || !reflect.DeepEqual(expected, abs.Records()) {
+		t.Errorf("Test-Abs\nExpected:\n%v\nReceived:\n%v", expected, abs)
+	}
+
+	expectedCompare := []bool{false, false, true}
+	received, err := s.Compare(Eq, "3-4i").Bool()
+	if err != nil || !reflect.DeepEqual(expectedCompare, received) {
+		t.Errorf("Test-Compare\nExpected:\n%v\nReceived:\n%v", expectedCompare, received)
+	}
+	expectedCompare = []bool{false, false, false}
+	received, err = s.Compare(Greater, 0).Bool()
+	if err != nil || !reflect.DeepEqual(expectedCompare, received) {
+		t.Errorf("Test-Compare-Greater\nExpected:\n%v\nReceived:\n%v", expectedCompare, received)
+	}
+
+	slice := s.ToSlice().([]complex128)
+	if slice[0] != 1+2i || !cmplx.IsNaN(slice[1]) || slice[2] != 3-4i {
+		t.Errorf("Test-ToSlice\nReceived:\n%v", slice)
+	}
+}
+
+func TestCategoricals(t *testing.T) {
+	table := []struct {
+		series     Series
+		expected   string
+		codes      []int
+		categories []string
+	}{
+		{
+			Categoricals([]string{"b", "a", "b", NaN, "c", "a"}),
+			"[b a b NaN c a]",
+			[]int{0, 1, 0, -1, 2, 1},
+			[]string{"b", "a", "c"},
+		},
+		{
+			Categoricals([]interface{}{1, 1.5, true, nil}),
+			"[1 1.500000 true NaN]",
+			[]int{0, 1, 2, -1},
+			[]string{"1", "1.500000", "true"},
+		},
+		{
+			New(Strings([]string{"x", "x"}), Categorical, ""),
+			"[x x]",
+			[]int{0, 0},
+			[]string{"x"},
+		},
+		{
+			Categoricals([]string{}),
+			"[]",
+			[]int{},
+			[]string{},
+		},
+	}
+	for testnum, test := range table {
+		if err := test.series.Error(); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		received := fmt.Sprint(test.series)
+		if test.expected != received {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+		if codes := test.series.Codes(); !reflect.DeepEqual(test.codes, codes) {
+			t.Errorf("Test-Codes:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.codes, codes)
+		}
+		if categories := test.series.Categories(); !reflect.DeepEqual(test.categories, categories) {
+			t.Errorf("Test-Categories:%v\nExpected:\n%v\nReceived:\n%v", testnum, test.categories, categories)
+		}
+		if err := checkTypes(test.series); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+	}
+
+	if Strings([]string{"a"}).Codes() != nil || Strings([]string{"a"}).Categories() != nil {
+		t.Errorf("Expected nil codes and categories for a String series")
+	}
+}
+
+func TestSeries_Categorical(t *testing.T) {
+	s := Categoricals([]string{"b", "a", NaN, "c", "a"})
+	str := Strings(s.Records())
+
+	for _, test := range []struct {
+		comparator Comparator
+		comparando interface{}
+	}{
+		{Eq, "a"},
+		{Neq, "a"},
+		{Less, "b"},
+		{In, []string{"a", "c"}},
+		{Eq, Categoricals([]string{"b", "b", "b", "c", "c"})},
+		{Eq, s},
+	} {
+		expected, _ := str.Compare(test.comparator, test.comparando).Bool()
+		received, err := s.Compare(test.comparator, test.comparando).Bool()
+		if err != nil || !reflect.DeepEqual(expected, received) {
+			t.Errorf("Test-Compare:%v %v\nExpected:\n%v\nReceived:\n%v",
+				test.comparator, test.comparando, expected, received)
+		}
+	}
+
+	if expected, received := str.Order(false), s.Order(false); !reflect.DeepEqual(expected, received) {
+		t.Errorf("Test-Order\nExpected:\n%v\nReceived:\n%v", expected, received)
+	}
+	if received := s.NUnique(); received != 3 {
+		t.Errorf("Test-NUnique\nExpected:\n%v\nReceived:\n%v", 3, received)
+	}
+
+	// the appended elements are encoded with the dictionary of s
+	appended := s.Copy()
+	appended.Append(Categoricals([]string{"d", "a"}))
+	expectedCodes := []int{0, 1, -1, 2, 1, 3, 1}
+	if codes := appended.Codes(); !reflect.DeepEqual(expectedCodes, codes) {
+		t.Errorf("Test-Append\nExpected:\n%v\nReceived:\n%v", expectedCodes, codes)
+	}
+	appended.Elem(0).Set("e")
+	if expected := []string{"b", "a", NaN, "c", "a"}; !reflect.DeepEqual(expected, s.Records()) {
+		t.Errorf("Test-Copy\nExpected:\n%v\nReceived:\n%v", expected, s.Records())
+	}
+	if expected := []string{"b", "a", "c"}; !reflect.DeepEqual(expected, s.Categories()) {
+		t.Errorf("Test-Copy-Categories\nExpected:\n%v\nReceived:\n%v", expected, s.Categories())
+	}
+
+	// the copies have their own dictionary so they can be set concurrently
+	var wg sync.WaitGroup
+	for w := 0; w < 2; w++ {
+		c := s.Copy()
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				c.Elem(i % c.Len()).Set(fmt.Sprintf("%d-%d", w, i))
+			}
+		}(w)
+	}
+	wg.Wait()
+	if expected := []string{"b", "a", "c"}; !reflect.DeepEqual(expected, s.Categories()) {
+		t.Errorf("Test-Copy-Concurrent\nExpected:\n%v\nReceived:\n%v", expected, s.Categories())
+	}
+
+	if received := s.MaxStr(); received != "c" {
+		t.Errorf("Test-MaxStr\nExpected:\n%v\nReceived:\n%v", "c", received)
+	}
+	if received := s.MinStr(); received != "a" {
+		t.Errorf("Test-MinStr\nExpected:\n%v\nReceived:\n%v", "a", received)
+	}
+
+	if received := s.As(String); received.Type() != String || !reflect.DeepEqual(str.Records(), received.Records()) {
+		t.Errorf("Test-As\nExpected:\n%v\nReceived:\n%v", str, received)
+	}
+	if !s.Equal(Categoricals([]string{"b", "a", NaN, "c", "a"})) {
+		t.Errorf("Test-Equal\nExpected equal series")
+	}
+}
+
+func TestElement_SetNA(t *testing.T) {
+	tests := []Series{
+		Ints([]int{1}),
+		Floats([]float64{1.5}),
+		Bools([]bool{true}),
+		Strings([]string{"a"}),
+		Times([]time.Time{time.Unix(1, 0)}),
+		Int64s([]int64{1}),
+		Complexes([]complex128{1i}),
+		Categoricals([]string{"a"}),
+	}
+	for testnum, test := range tests {
+		ele := test.Elem(0)
+		ele.SetNA()
+		if !ele.IsNA() || ele.Val() != nil || ele.String() != NaN {
+			t.Errorf("Test:%v\nExpected NA element of type %v, received:\n%v", testnum, test.Type(), ele)
+		}
+		if ele.Type() != test.Type() {
+			t.Errorf("Test:%v\nExpected type %v, received %v", testnum, test.Type(), ele.Type())
+		}
+		if !test.HasNaN() {
+			t.Errorf("Test:%v\nExpected the series to be modified:\n%v", testnum, test)
+		}
+	}
+}
+
+func TestSeries_Copy(t *testing.T) {
+	tests := []Series{
+		Strings([]string{"1", "2", "3", "a", "b", "c"}),
+		Ints([]string{"1", "2", "3", "a", "b", "c"}),
+		Floats([]string{"1", "2", "3", "a", "b", "c"}),
+		Bools([]string{"1", "0", "1", "t", "f", "c"}),
+	}
+	for testnum, test := range tests {
+		a := test
+		b := a.Copy()
+		if fmt.Sprint(a) != fmt.Sprint(b) {
+			t.Error("Different values when copying String elements")
+		}
+		if err := b.Error(); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		if err := checkTypes(b); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		//if err := checkAddr(a.Addr(), b.Addr()); err != nil {
+		//t.Errorf("Test:%v\nError:%v\nA:%v\nB:%v", testnum, err, a.Addr(), b.Addr())
+		//}
+	}
+}
+
+func TestSeries_As(t *testing.T) {
+	tests := []struct {
+		series   Series
+		t        Type
+		expected Series
+	}{
+		{
+			Strings([]string{"1", NaN, "3"}),
+			Int,
+			Ints([]string{"1", NaN, "3"}),
+		},
+		{
+			Floats([]float64{1.5, 0}),
+			String,
+			Strings([]string{"1.500000", "0.000000"}),
+		},
+		{
+			Ints([]int{1, 0}),
+			Bool,
+			Bools([]bool{true, false}),
+		},
+		{
+			Bools([]bool{true, false}),
+			Float,
+			Floats([]float64{1, 0}),
+		},
+		{
+			Floats([]string{"2", NaN, "-3"}),
+			Int64,
+			Int64s([]string{"2", NaN, "-3"}),
+		},
+		{
+			Strings([]string{"2021-03-01T12:30:00Z"}),
+			DateTime,
+			Times([]time.Time{time.Date(2021, 3, 1, 12, 30, 0, 0, time.UTC)}),
+		},
+	}
+	for testnum, test := range tests {
+		test.series.SetName("name")
+		received := test.series.As(test.t)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if received.Type() != test.t || received.Name() != "name" ||
+			!reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	errTests := []struct {
+		series Series
+		t      Type
+	}{
+		{Strings([]string{"1", "A"}), Int},
+		{Ints([]int{1, 2}), Bool},
+		{Strings([]string{"1"}), Type("decimal")},
+		{Floats([]float64{1, 1.5}), Int},
+		{Floats([]float64{-2.5}), Int64},
+		{Floats([]float64{1e300}), Int64},
+	}
+	for testnum, test := range errTests {
+		received := test.series.As(test.t)
+		if err := received.Error(); err == nil {
+			t.Errorf("Test-Error:%v\nExpected error, received:\n%v", testnum, received)
+		}
+	}
+}
+
+func TestSeries_Records(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected []string
+	}{
+		{
+			Strings([]string{"1", "2", "3", "a", "b", "c"}),
+			[]string{"1", "2", "3", "a", "b", "c"},
+		},
+		{
+			Ints([]string{"1", "2", "3", "a", "b", "c"}),
+			[]string{"1", "2", "3", "NaN", "NaN", "NaN"},
+		},
+		{
+			Floats([]string{"1", "2", "3", "a", "b", "c"}),
+			[]string{"1.000000", "2.000000", "3.000000", "NaN", "NaN", "NaN"},
+		},
+		{
+			Bools([]string{"1", "0", "1", "t", "f", "c"}),
+			[]string{"true", "false", "true", "true", "false", "NaN"},
+		},
+	}
+	for testnum, test := range tests {
+		expected := test.expected
+		received := test.series.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_RecordsFormat(t *testing.T) {
+	tests := []struct {
+		series   Series
+		floatFmt string
+		expected []string
+	}{
+		{
+			Floats([]string{"1", "2.123456789", NaN, "-0.00005"}),
+			"%.4f",
+			[]string{"1.0000", "2.1235", "NaN", "-0.0001"},
+		},
+		{
+			Floats([]float64{1234.5}),
+			"%.2e",
+			[]string{"1.23e+03"},
+		},
+		{
+			Ints([]string{"1", NaN}),
+			"%.4f",
+			[]string{"1", "NaN"},
+		},
+		{
+			Strings([]string{"a", "1.5"}),
+			"%.4f",
+			[]string{"a", "1.5"},
+		},
+	}
+	for testnum, test := range tests {
+		expected := test.expected
+		received := test.series.RecordsFormat(test.floatFmt)
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_ToSlice(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected interface{}
+	}{
+		{
+			Ints([]string{"1", NaN, "3"}),
+			[]int{1, 0, 3},
+		},
+		{
+			Floats([]float64{1.5, 2}),
+			[]float64{1.5, 2},
+		},
+		{
+			Bools([]string{"true", NaN, "false"}),
+			[]bool{true, false, false},
+		},
+		{
+			Strings([]string{"a", NaN}),
+			[]string{"a", NaN},
+		},
+	}
+	for testnum, test := range tests {
+		expected := test.expected
+		received := test.series.ToSlice()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_Float(t *testing.T) {
+	precision := 0.0000001
+	floatEquals := func(x, y []float64) bool {
+		if len(x) != len(y) {
+			return false
+		}
+		for i := 0; i < len(x); i++ {
+			a := x[i]
+			b := y[i]
+			if (a-b) > precision || (b-a) > precision {
+				return false
+			}
+		}
+		return true
+	}
+	tests := []struct {
+		series   Series
+		expected []float64
+	}{
+		{
+			Strings([]string{"1", "2", "3", "a", "b", "c"}),
+			[]float64{1, 2, 3, math.NaN(), math.NaN(), math.NaN()},
+		},
+		{
+			Ints([]string{"1", "2", "3", "a", "b", "c"}),
+			[]float64{1, 2, 3, math.NaN(), math.NaN(), math.NaN()},
+		},
+		{
+			Floats([]string{"1", "2", "3", "a", "b", "c"}),
+			[]float64{1, 2, 3, math.NaN(), math.NaN(), math.NaN()},
+		},
+		{
+			Bools([]string{"1", "0", "1", "t", "f", "c"}),
+			[]float64{1, 0, 1, 1, 0, math.NaN()},
+		},
+	}
+	for testnum, test := range tests {
+		expected := test.expected
+		received := test.series.Float()
+		if !floatEquals(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_Concat(t *testing.T) {
+	tests := []struct {
+		a        Series
+		b        Series
+		expected []string
+	}{
+		{
+			Strings([]string{"1", "2", "3"}),
+			Strings([]string{"a", "b", "c"}),
+			[]string{"1", "2", "3", "a", "b", "c"},
+		},
 		{
 			Ints([]string{"1", "2", "3"}),
 			Ints([]string{"a", "4", "c"}),
@@ -1165,6 +1955,101 @@ func TestSeries_Concat(t *testing.T) {
 	}
 }
 
+func TestSeries_AppendElement(t *testing.T) {
+	tests := []struct {
+		series   Series
+		elements Series
+		expected []string
+	}{
+		{
+			Ints([]int{1}),
+			Ints([]string{"2", NaN}),
+			[]string{"1", "2", "NaN"},
+		},
+		{
+			Floats([]float64{}),
+			Strings([]string{"1.5", "a"}),
+			[]string{"1.500000", "NaN"},
+		},
+		{
+			Strings([]string{"a"}),
+			Bools([]bool{true}),
+			[]string{"a", "true"},
+		},
+		{
+			Bools([]bool{false}),
+			Ints([]int{1}).Immutable(),
+			[]string{"false", "true"},
+		},
+	}
+	for testnum, test := range tests {
+		for i := 0; i < test.elements.Len(); i++ {
+			test.series.AppendElement(test.elements.Elem(i))
+		}
+		received := test.series.Records()
+		if !reflect.DeepEqual(test.expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	cs := Ints([]int{1}).CacheAble()
+	_ = cs.Sum()
+	cs.AppendElement(Ints([]int{2}).Elem(0))
+	if sum := cs.Sum(); sum != 3 {
+		t.Errorf("Cacheable: Expected sum 3, received %v", sum)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	tests := []struct {
+		ss       []Series
+		expected []string
+	}{
+		{
+			[]Series{Strings([]string{"1", "2"}), Strings([]string{"a"}), Strings([]string{}), Strings([]string{"b", NaN})},
+			[]string{"1", "2", "a", "b", "NaN"},
+		},
+		{
+			[]Series{Ints([]string{"1", "a"}), Floats([]float64{4})},
+			[]string{"1", "NaN", "4"},
+		},
+		{
+			[]Series{Floats([]float64{1.5})},
+			[]string{"1.500000"},
+		},
+	}
+	for testnum, test := range tests {
+		test.ss[0].SetName("first")
+		received := Concat(test.ss...)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		if received.Name() != "first" || received.Type() != test.ss[0].Type() {
+			t.Errorf("Test:%v\nExpected name first and type %v, received %v and %v",
+				testnum, test.ss[0].Type(), received.Name(), received.Type())
+		}
+		if !reflect.DeepEqual(test.expected, received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received.Records(),
+			)
+		}
+		received.Elem(0).SetNA()
+		if test.ss[0].Elem(0).IsNA() {
+			t.Errorf("Test:%v\nConcat must not share elements with the series", testnum)
+		}
+	}
+
+	failed := Ints([]int{1})
+	failed.SetErr(fmt.Errorf("failed"))
+	if Concat(Ints([]int{1}), failed).Error() == nil || Concat(failed, Ints([]int{1})).Error() == nil || Concat().Error() == nil {
+		t.Errorf("Expected error")
+	}
+}
+
 func TestSeries_Order(t *testing.T) {
 	tests := []struct {
 		series   Series
@@ -1250,6 +2135,45 @@ func TestSeries_IsNaN(t *testing.T) {
 	}
 }
 
+func TestSeries_ValidIndex(t *testing.T) {
+	tests := []struct {
+		series        Series
+		expectedFirst int
+		expectedLast  int
+	}{
+		{
+			Floats([]string{"NaN", "NaN", "1", "NaN", "4", "NaN"}),
+			2,
+			4,
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			0,
+			2,
+		},
+		{
+			Strings([]string{"NaN", "NaN"}),
+			-1,
+			-1,
+		},
+		{
+			Floats([]float64{}),
+			-1,
+			-1,
+		},
+	}
+	for testnum, test := range tests {
+		first := test.series.FirstValidIndex()
+		last := test.series.LastValidIndex()
+		if first != test.expectedFirst || last != test.expectedLast {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v %v\nReceived:\n%v %v",
+				testnum, test.expectedFirst, test.expectedLast, first, last,
+			)
+		}
+	}
+}
+
 func TestSeries_StdDev(t *testing.T) {
 	tests := []struct {
 		series   Series
@@ -1289,6 +2213,51 @@ func TestSeries_StdDev(t *testing.T) {
 	}
 }
 
+func TestSeries_StdDevPopulation(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected float64
+	}{
+		{
+			Ints([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}),
+			2.8722813232690143,
+		},
+		{
+			Floats([]float64{1.0, 2.0, 3.0}),
+			0.816496580927726,
+		},
+		{
+			Floats([]float64{5}),
+			0,
+		},
+		{
+			Strings([]string{"A", "B", "C", "D"}),
+			math.NaN(),
+		},
+		{
+			Bools([]bool{true, true, false, true}),
+			0.4330127018922193,
+		},
+		{
+			Floats([]float64{}),
+			math.NaN(),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.expected
+		for _, s := range []Series{test.series, test.series.CacheAble()} {
+			received := s.StdDevPopulation()
+			if !compareFloats(received, expected, 6) {
+				t.Errorf(
+					"Test:%v\nExpected:\n%v\nReceived:\n%v",
+					testnum, expected, received,
+				)
+			}
+		}
+	}
+}
+
 func TestSeries_Mean(t *testing.T) {
 	tests := []struct {
 		series   Series
@@ -1915,6 +2884,146 @@ func TestSeries_Map(t *testing.T) {
 		}
 	}
 }
+func TestSeries_MapParallel(t *testing.T) {
+	square := func(ele Element, index int) Element {
+		result := ele.Copy()
+		if !result.IsNA() {
+			result.Set(ele.Float()*ele.Float() + float64(index))
+		}
+		return result
+	}
+	tests := []struct {
+		series  Series
+		workers int
+	}{
+		{
+			Floats([]string{"1.5", NaN, "-2", "3", "4", "5", "6"}),
+			3,
+		},
+		{
+			Ints([]int{1, 2, 3, 4, 5}),
+			10,
+		},
+		{
+			Ints([]int{1, 2, 3, 4, 5}),
+			0,
+		},
+		{
+			Floats([]float64{}),
+			4,
+		},
+	}
+	for testnum, test := range tests {
+		expected := test.series.Map(square)
+		received := test.series.MapParallel(square, test.workers)
+		if received.Type() != expected.Type() || !reflect.DeepEqual(expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_TryMap(t *testing.T) {
+	parseFloat := func(ele Element, index int) (Element, error) {
+		result := ele.Copy()
+		if ele.IsNA() {
+			return result, nil
+		}
+		f, err := strconv.ParseFloat(ele.String(), 64)
+		if err != nil {
+			return nil, err
+		}
+		result.Set(strconv.FormatFloat(f*2, 'f', -1, 64))
+		return result, nil
+	}
+	tests := []struct {
+		series   Series
+		expected Series
+		err      bool
+	}{
+		{
+			Strings([]string{"1.5", NaN, "-2"}),
+			Strings([]string{"3", NaN, "-4"}),
+			false,
+		},
+		{
+			Strings([]string{"1.5", "A", "-2"}),
+			Strings([]string{}),
+			true,
+		},
+	}
+	for testnum, test := range tests {
+		received := test.series.TryMap(parseFloat)
+		if err := received.Error(); (err != nil) != test.err {
+			t.Errorf("Test:%v\nUnexpected error state: %v", testnum, err)
+		}
+		if !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_Reduce(t *testing.T) {
+	weights := []float64{0.5, 0.25, 0.25}
+	weightedSum := func(acc interface{}, ele Element, index int) interface{} {
+		if ele.IsNA() {
+			return acc
+		}
+		return acc.(float64) + ele.Float()*weights[index]
+	}
+	longest := func(acc interface{}, ele Element, index int) interface{} {
+		if len(ele.String()) > len(acc.(string)) {
+			return ele.String()
+		}
+		return acc
+	}
+	tests := []struct {
+		series   Series
+		init     interface{}
+		f        func(acc interface{}, ele Element, index int) interface{}
+		expected interface{}
+	}{
+		{
+			Floats([]float64{4, 2, 6}),
+			0.0,
+			weightedSum,
+			4.0,
+		},
+		{
+			Ints([]string{"4", NaN, "8"}),
+			1.0,
+			weightedSum,
+			5.0,
+		},
+		{
+			Strings([]string{"a", "abc", "ab"}),
+			"",
+			longest,
+			"abc",
+		},
+		{
+			Strings([]string{}),
+			"init",
+			longest,
+			"init",
+		},
+	}
+	for testnum, test := range tests {
+		received := test.series.Reduce(test.init, test.f)
+		if !reflect.DeepEqual(test.expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
 func TestSeries_Shift(t *testing.T) {
 	tests := []struct {
 		series   Series
@@ -1927,51 +3036,167 @@ func TestSeries_Shift(t *testing.T) {
 			Bools([]string{"NaN", "NaN", "false", "true", "false"}),
 		},
 		{
-			Bools([]string{"false", "true", "false", "false", "true"}),
+			Bools([]string{"false", "true", "false", "false", "true"}),
+			-2,
+			Bools([]string{"false", "false", "true", "NaN", "NaN"}),
+		},
+		{
+			Floats([]string{"1.5", "-3.23", "-0.337397", "-0.380079", "1.60979", "34."}),
+			-1,
+			Floats([]string{"-3.23", "-0.337397", "-0.380079", "1.60979", "34.", "NaN"}),
+		},
+		{
+			Floats([]string{"1.5", "-3.23", "-0.337397", "-0.380079", "1.60979", "34."}),
+			1,
+			Floats([]string{"NaN", "1.5", "-3.23", "-0.337397", "-0.380079", "1.60979"}),
+		},
+
+		{
+			Strings([]string{"XyZApple", "XyZBanana", "XyZCitrus", "XyZDragonfruit"}),
+			2,
+			Strings([]string{"NaN", "NaN", "XyZApple", "XyZBanana"}),
+		},
+		{
+			Strings([]string{"San Francisco", "XyZTokyo", "MoscowXyZ", "XyzSydney"}),
+			-2,
+			Strings([]string{"MoscowXyZ", "XyzSydney", "NaN", "NaN"}),
+		},
+		{
+			Ints([]string{"23", "13", "101", "-64", "-3"}),
+			2,
+			Ints([]string{"NaN", "NaN", "23", "13", "101"}),
+		},
+		{
+			Ints([]string{"23", "13", "101", "-64", "-3"}),
+			-2,
+			Ints([]string{"101", "-64", "-3", "NaN", "NaN"}),
+		},
+		{
+			Ints([]string{"23", "13", "101", "-64", "-3"}),
+			0,
+			Ints([]string{"23", "13", "101", "-64", "-3"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.expected.Records()
+		b := test.series.Shift(test.shift)
+		received := b.Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		if err := checkTypes(b); err != nil {
+			t.Errorf(
+				"Test:%v\nError:%v",
+				testnum, err,
+			)
+		}
+	}
+}
+
+func TestSeries_Shift_NAPadding(t *testing.T) {
+	tests := []struct {
+		series   Series
+		shift    int
+		expected []bool
+	}{
+		{
+			Ints([]int{1, 2, 3}),
+			2,
+			[]bool{true, true, false},
+		},
+		{
+			Bools([]bool{true, false, true}),
+			-1,
+			[]bool{false, false, true},
+		},
+		{
+			Floats([]float64{1, 2}),
+			1,
+			[]bool{true, false},
+		},
+		{
+			Strings([]string{"a", "b"}),
+			-1,
+			[]bool{false, true},
+		},
+	}
+
+	for testnum, test := range tests {
+		shifted := test.series.Shift(test.shift)
+		received := make([]bool, shifted.Len())
+		for i := range received {
+			received[i] = shifted.Elem(i).IsNA()
+		}
+		if !reflect.DeepEqual(test.expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+		if shifted.Type() != test.series.Type() {
+			t.Errorf("Test:%v\nExpected type %v, received %v", testnum, test.series.Type(), shifted.Type())
+		}
+	}
+}
+
+func TestSeries_ShiftFill(t *testing.T) {
+	tests := []struct {
+		series   Series
+		shift    int
+		fill     ElementValue
+		expected Series
+	}{
+		{
+			Ints([]int{1, 2, 3}),
+			1,
+			0,
+			Ints([]int{0, 1, 2}),
+		},
+		{
+			Floats([]float64{1.5, 2, 3}),
 			-2,
-			Bools([]string{"false", "false", "true", "NaN", "NaN"}),
-		},
-		{
-			Floats([]string{"1.5", "-3.23", "-0.337397", "-0.380079", "1.60979", "34."}),
-			-1,
-			Floats([]string{"-3.23", "-0.337397", "-0.380079", "1.60979", "34.", "NaN"}),
+			"-1",
+			Floats([]float64{3, -1, -1}),
 		},
 		{
-			Floats([]string{"1.5", "-3.23", "-0.337397", "-0.380079", "1.60979", "34."}),
+			Strings([]string{"a", "b"}),
 			1,
-			Floats([]string{"NaN", "1.5", "-3.23", "-0.337397", "-0.380079", "1.60979"}),
-		},
-
-		{
-			Strings([]string{"XyZApple", "XyZBanana", "XyZCitrus", "XyZDragonfruit"}),
-			2,
-			Strings([]string{"NaN", "NaN", "XyZApple", "XyZBanana"}),
+			"",
+			Strings([]string{"", "a"}),
 		},
 		{
-			Strings([]string{"San Francisco", "XyZTokyo", "MoscowXyZ", "XyzSydney"}),
-			-2,
-			Strings([]string{"MoscowXyZ", "XyzSydney", "NaN", "NaN"}),
+			Bools([]bool{true, true}),
+			-1,
+			false,
+			Bools([]bool{true, false}),
 		},
 		{
-			Ints([]string{"23", "13", "101", "-64", "-3"}),
-			2,
-			Ints([]string{"NaN", "NaN", "23", "13", "101"}),
+			Ints([]int{1, 2, 3}),
+			5,
+			7,
+			Ints([]int{7, 7, 7}),
 		},
 		{
-			Ints([]string{"23", "13", "101", "-64", "-3"}),
-			-2,
-			Ints([]string{"101", "-64", "-3", "NaN", "NaN"}),
+			Ints([]int{1, 2, 3}),
+			-4,
+			nil,
+			Ints([]string{NaN, NaN, NaN}),
 		},
 		{
-			Ints([]string{"23", "13", "101", "-64", "-3"}),
+			Ints([]int{1, 2, 3}),
 			0,
-			Ints([]string{"23", "13", "101", "-64", "-3"}),
+			0,
+			Ints([]int{1, 2, 3}),
 		},
 	}
 
 	for testnum, test := range tests {
 		expected := test.expected.Records()
-		b := test.series.Shift(test.shift)
+		b := test.series.ShiftFill(test.shift, test.fill)
 		received := b.Records()
 		if !reflect.DeepEqual(expected, received) {
 			t.Errorf(
@@ -1979,12 +3204,44 @@ func TestSeries_Shift(t *testing.T) {
 				testnum, expected, received,
 			)
 		}
-		if err := checkTypes(b); err != nil {
+		if b.Type() != test.series.Type() {
+			t.Errorf("Test:%v\nExpected type %v, received %v", testnum, test.series.Type(), b.Type())
+		}
+	}
+}
+
+func TestSeries_Lags(t *testing.T) {
+	s := Ints([]int{1, 2, 3, 4})
+	s.SetName("x")
+	tests := []struct {
+		period   int
+		expected Series
+	}{
+		{1, Ints([]string{NaN, "1", "2", "3"})},
+		{2, Ints([]string{NaN, NaN, "1", "2"})},
+		{-1, Ints([]string{"2", "3", "4", NaN})},
+		{0, Ints([]int{1, 2, 3, 4})},
+	}
+
+	periods := make([]int, len(tests))
+	for i, test := range tests {
+		periods[i] = test.period
+	}
+	lags := s.Lags(periods...)
+	if len(lags) != len(tests) {
+		t.Fatalf("Expected %d series, received %d", len(tests), len(lags))
+	}
+	for testnum, test := range tests {
+		received := lags[testnum]
+		if !reflect.DeepEqual(test.expected.Records(), received.Records()) {
 			t.Errorf(
-				"Test:%v\nError:%v",
-				testnum, err,
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
 			)
 		}
+		if name := fmt.Sprintf("x_lag(%d)", test.period); received.Name() != name {
+			t.Errorf("Test:%v\nExpected name %v, received %v", testnum, name, received.Name())
+		}
 	}
 }
 
@@ -2331,6 +3588,50 @@ func TestSeries_Slice(t *testing.T) {
 	}
 }
 
+func TestSeries_SliceStep(t *testing.T) {
+	tests := []struct {
+		start    int
+		end      int
+		step     int
+		expected []string
+	}{
+		{0, 5, 2, []string{"1", "3", "5"}},
+		{1, 5, 3, []string{"2", "5"}},
+		{2, 2, 1, []string{}},
+		{4, -1, -1, []string{"5", "4", "3", "2", "1"}},
+		{4, 0, -2, []string{"5", "3"}},
+		{0, 6, 1, nil},
+		{5, 0, -1, nil},
+		{0, 2, 0, nil},
+	}
+
+	s := Ints([]int{1, 2, 3, 4, 5})
+	for testnum, test := range tests {
+		received := s.SliceStep(test.start, test.end, test.step)
+		if test.expected == nil {
+			if received.Error() == nil {
+				t.Errorf("Test:%v\nExpected error, received:\n%v", testnum, received)
+			}
+			continue
+		}
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nUnexpected error: %v", testnum, err)
+		}
+		if received.Type() != Int || !reflect.DeepEqual(test.expected, received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	received := s.SliceStep(0, 2, 1)
+	received.Elem(0).SetInt(9)
+	if s.Elem(0).String() != "1" {
+		t.Errorf("SliceStep must not share elements with the series")
+	}
+}
+
 func TestSeries_Filter(t *testing.T) {
 	tests := []struct {
 		ff       FilterFunction
@@ -2384,3 +3685,328 @@ func TestSeries_Filter(t *testing.T) {
 		}
 	}
 }
+
+func TestSeries_ForEach(t *testing.T) {
+	tests := []struct {
+		series   Series
+		stop     string
+		expected []string
+	}{
+		{
+			Strings([]string{"a", "b", NaN, "c"}),
+			"",
+			[]string{"a", "b", NaN, "c"},
+		},
+		{
+			Ints([]int{1, 2, 3, 4}),
+			"2",
+			[]string{"1", "2"},
+		},
+		{
+			Floats([]float64{}),
+			"",
+			[]string{},
+		},
+	}
+
+	for testnum, test := range tests {
+		received := []string{}
+		test.series.ForEach(func(i int, e Element) bool {
+			if i != len(received) {
+				t.Errorf("Test:%v\nExpected index %v, received %v", testnum, len(received), i)
+			}
+			received = append(received, e.String())
+			return e.String() != test.stop
+		})
+		if !reflect.DeepEqual(test.expected, received) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_FindIndexOf(t *testing.T) {
+	tests := []struct {
+		series  Series
+		ff      FilterFunction
+		value   interface{}
+		find    int
+		indexOf int
+	}{
+		{
+			Ints([]string{NaN, "3", "5", "3"}),
+			func(ele Element, index int) bool {
+				v, err := ele.Int()
+				return err == nil && v > 3
+			},
+			"3",
+			2,
+			1,
+		},
+		{
+			Strings([]string{"a", NaN, "b"}),
+			func(ele Element, index int) bool {
+				return ele.IsNA()
+			},
+			NaN,
+			1,
+			-1,
+		},
+		{
+			Floats([]float64{1.5, 2}),
+			func(ele Element, index int) bool {
+				return false
+			},
+			2,
+			-1,
+			1,
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			func(ele Element, index int) bool {
+				return ele.Float() > 1
+			},
+			2.5,
+			1,
+			-1,
+		},
+		{
+			Int64s([]int64{1, 2, 3}),
+			func(ele Element, index int) bool {
+				return false
+			},
+			2.0,
+			-1,
+			1,
+		},
+		{
+			Bools([]bool{}),
+			func(ele Element, index int) bool {
+				return true
+			},
+			true,
+			-1,
+			-1,
+		},
+	}
+
+	for testnum, test := range tests {
+		if received := test.series.Find(test.ff); received != test.find {
+			t.Errorf(
+				"Test-Find:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.find, received,
+			)
+		}
+		if received := test.series.IndexOf(test.value); received != test.indexOf {
+			t.Errorf(
+				"Test-IndexOf:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.indexOf, received,
+			)
+		}
+	}
+}
+
+func TestOperationTyped(t *testing.T) {
+	add := func(index int, eles ...Element) interface{} {
+		return eles[0].Float() + eles[1].Float()
+	}
+	ints := Ints([]int{1, 2, 3})
+	floats := Floats([]float64{0.5, 0.5, 0.5})
+
+	truncated, err := Operation(add, ints, floats)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := []string{"1", "2", "3"}
+	if truncated.Type() != Int || !reflect.DeepEqual(expected, truncated.Records()) {
+		t.Errorf("Test-Operation\nExpected:\n%v\nReceived:\n%v", expected, truncated)
+	}
+
+	received, err := OperationTyped(Float, add, ints, floats)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected = Floats([]float64{1.5, 2.5, 3.5}).Records()
+	if received.Type() != Float || !reflect.DeepEqual(expected, received.Records()) {
+		t.Errorf("Test-OperationTyped\nExpected:\n%v\nReceived:\n%v", expected, received)
+	}
+
+	received, err = OperationTyped(Float, add, ints, Floats([]float64{0.5}))
+	if err != nil || !reflect.DeepEqual(expected, received.Records()) {
+		t.Errorf("Test-OperationTyped-Broadcast\nExpected:\n%v\nReceived:\n%v", expected, received)
+	}
+
+	if _, err := OperationTyped(Type("unknown"), add, ints, floats); err == nil {
+		t.Errorf("Expected error for unknown type")
+	}
+	if _, err := OperationTyped(Float, add, ints, Floats([]float64{1, 2})); err == nil {
+		t.Errorf("Expected error for length mismatch")
+	}
+	if _, err := OperationTyped(Float, add); err == nil {
+		t.Errorf("Expected error without series")
+	}
+}
+
+func TestSeries_Arithmetic(t *testing.T) {
+	tests := []struct {
+		series      Series
+		c           Series
+		addExpected Series
+		subExpected Series
+		mulExpected Series
+		divExpected Series
+	}{
+		{
+			Floats([]float64{1, 2, 3}),
+			Ints([]int{2, 4, 6}),
+			Floats([]float64{3, 6, 9}),
+			Floats([]float64{-1, -2, -3}),
+			Floats([]float64{2, 8, 18}),
+			Floats([]float64{0.5, 0.5, 0.5}),
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			Floats([]float64{2}),
+			Floats([]float64{3, 4, 5}),
+			Floats([]float64{-1, 0, 1}),
+			Floats([]float64{2, 4, 6}),
+			Floats([]float64{0.5, 1, 1.5}),
+		},
+		{
+			Floats([]float64{4}),
+			Floats([]float64{2}),
+			Floats([]float64{6}),
+			Floats([]float64{2}),
+			Floats([]float64{8}),
+			Floats([]float64{2}),
+		},
+	}
+
+	for testnum, test := range tests {
+		for _, op := range []struct {
+			name     string
+			received Series
+			expected Series
+		}{
+			{"Add", test.series.Add(test.c), test.addExpected},
+			{"Sub", test.series.Sub(test.c), test.subExpected},
+			{"Mul", test.series.Mul(test.c), test.mulExpected},
+			{"Div", test.series.Div(test.c), test.divExpected},
+		} {
+			if err := op.received.Error(); err != nil {
+				t.Errorf("Test-%s:%v\nUnexpected error: %v", op.name, testnum, err)
+			}
+			if !reflect.DeepEqual(op.expected.Records(), op.received.Records()) {
+				t.Errorf(
+					"Test-%s:%v\nExpected:\n%v\nReceived:\n%v",
+					op.name, testnum, op.expected, op.received,
+				)
+			}
+		}
+	}
+
+	s := Floats([]float64{1, 2, 3})
+	withErr := Floats([]float64{1, 2, 3})
+	withErr.SetErr(fmt.Errorf("error"))
+	for _, c := range []Series{Floats([]float64{1, 2}), Floats([]float64{}), withErr} {
+		for _, received := range []Series{s.Add(c), s.Sub(c), s.Mul(c), s.Div(c), withErr.Add(s)} {
+			if err := received.Error(); err == nil {
+				t.Errorf("Expected error for operand %v, received:\n%v", c, received)
+			}
+		}
+	}
+	if err := s.Add(Floats([]float64{1, 2})).Error(); !strings.Contains(err.Error(), "length mismatch (3 != 2)") {
+		t.Errorf("Expected length mismatch error, received: %v", err)
+	}
+}
+
+func TestSeries_Abs(t *testing.T) {
+	tests := []struct {
+		series   Series
+		expected Series
+	}{
+		{
+			Ints([]string{"-3", NaN, "0", "7"}),
+			Ints([]string{"3", NaN, "0", "7"}),
+		},
+		{
+			Ints([]int{-math.MaxInt32, math.MaxInt32 - 1, math.MinInt32 + 1}),
+			Ints([]int{math.MaxInt32, math.MaxInt32 - 1, math.MaxInt32}),
+		},
+		{
+			Ints([]string{"-9007199254740993", "9007199254740993", strconv.Itoa(-1 << (strconv.IntSize - 1))}),
+			Ints([]string{"9007199254740993", "9007199254740993", NaN}),
+		},
+		{
+			Floats([]string{"-1.5", NaN, "2"}),
+			Floats([]string{"1.5", NaN, "2"}),
+		},
+	}
+	for testnum, test := range tests {
+		received := test.series.Abs()
+		if received.Type() != test.expected.Type() || !reflect.DeepEqual(test.expected.Records(), received.Records()) {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+}
+
+func TestSeries_HeadTail(t *testing.T) {
+	tests := []struct {
+		series       Series
+		n            int
+		headExpected Series
+		tailExpected Series
+	}{
+		{
+			Ints([]int{1, 2, 3, 4, 5}),
+			2,
+			Ints([]int{1, 2}),
+			Ints([]int{4, 5}),
+		},
+		{
+			Strings([]string{"a", "b"}),
+			5,
+			Strings([]string{"a", "b"}),
+			Strings([]string{"a", "b"}),
+		},
+		{
+			Floats([]float64{1, 2}),
+			0,
+			Floats([]float64{}),
+			Floats([]float64{}),
+		},
+		{
+			Floats([]float64{1, 2}),
+			-1,
+			Floats([]float64{}),
+			Floats([]float64{}),
+		},
+		{
+			Floats([]float64{}),
+			3,
+			Floats([]float64{}),
+			Floats([]float64{}),
+		},
+	}
+	for testnum, test := range tests {
+		received := test.series.Head(test.n)
+		if err := received.Error(); err != nil || !reflect.DeepEqual(test.headExpected.Records(), received.Records()) {
+			t.Errorf(
+				"Test-Head:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.headExpected, received,
+			)
+		}
+		received = test.series.Tail(test.n)
+		if err := received.Error(); err != nil || !reflect.DeepEqual(test.tailExpected.Records(), received.Records()) {
+			t.Errorf(
+				"Test-Tail:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.tailExpected, received,
+			)
+		}
+	}
+}
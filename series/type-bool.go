@@ -84,6 +84,11 @@ func (e *boolElement) SetString(val string) {
 	}
 }
 
+func (e *boolElement) SetNA() {
+	e.e = false
+	e.nan = true
+}
+
 func (e boolElement) Copy() Element {
 	if e.IsNA() {
 		return &boolElement{false, true}
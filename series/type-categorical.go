@@ -0,0 +1,192 @@
+package series
+
+// categories is the dictionary of a Categorical series. It is append-only so the codes
+// stay valid while it is shared by the slices of the series, the copies clone it.
+type categories struct {
+	values []string
+	codes  map[string]int32
+}
+
+func newCategories() *categories {
+	return &categories{
+		codes: make(map[string]int32),
+	}
+}
+
+// clone returns a copy of the dictionary that can be extended independently of c.
+func (c *categories) clone() *categories {
+	ret := &categories{
+		values: make([]string, len(c.values)),
+		codes:  make(map[string]int32, len(c.codes)),
+	}
+	copy(ret.values, c.values)
+	for val, code := range c.codes {
+		ret.codes[val] = code
+	}
+	return ret
+}
+
+// code returns the code of val, adding it to the dictionary if needed.
+func (c *categories) code(val string) int32 {
+	code, ok := c.codes[val]
+	if !ok {
+		code = int32(len(c.values))
+		c.values = append(c.values, val)
+		c.codes[val] = code
+	}
+	return code
+}
+
+// codeNaN is the code of the NaN elements.
+const codeNaN int32 = -1
+
+// categoricalElement is a String element stored as a code in the dictionary,
+// it points to the code kept by the categoricalElements.
+type categoricalElement struct {
+	dict *categories
+	code *int32
+}
+
+// force categoricalElement struct to implement Element interface
+var _ Element = (*categoricalElement)(nil)
+
+// newCategoricalElement returns a NaN element with its own dictionary.
+func newCategoricalElement() *categoricalElement {
+	code := codeNaN
+	return &categoricalElement{newCategories(), &code}
+}
+
+func (e *categoricalElement) Set(value interface{}) {
+	var s stringElement
+	s.Set(value)
+	e.SetElement(&s)
+}
+
+func (e *categoricalElement) SetElement(val Element) {
+	if val.IsNA() {
+		e.SetNA()
+		return
+	}
+	if o, ok := val.(*categoricalElement); ok && o.dict == e.dict {
+		*e.code = *o.code
+		return
+	}
+	e.setString(val.String())
+}
+
+func (e *categoricalElement) SetBool(val bool) {
+	var s stringElement
+	s.SetBool(val)
+	e.SetElement(&s)
+}
+
+func (e *categoricalElement) SetFloat(val float64) {
+	var s stringElement
+	s.SetFloat(val)
+	e.SetElement(&s)
+}
+
+func (e *categoricalElement) SetInt(val int) {
+	var s stringElement
+	s.SetInt(val)
+	e.SetElement(&s)
+}
+
+func (e *categoricalElement) SetString(val string) {
+	if val == NaN {
+		e.SetNA()
+		return
+	}
+	e.setString(val)
+}
+
+func (e *categoricalElement) SetNA() {
+	*e.code = codeNaN
+}
+
+func (e *categoricalElement) setString(val string) {
+	*e.code = e.dict.code(val)
+}
+
+// Copy returns an element with its own dictionary holding only the category of e.
+func (e categoricalElement) Copy() Element {
+	ret := newCategoricalElement()
+	if !e.IsNA() {
+		ret.setString(e.dict.values[*e.code])
+	}
+	return ret
+}
+
+func (e categoricalElement) IsNA() bool {
+	return *e.code == codeNaN
+}
+
+func (e categoricalElement) Type() Type {
+	return Categorical
+}
+
+// Val returns the category of the element as a string.
+func (e categoricalElement) Val() ElementValue {
+	if e.IsNA() {
+		return nil
+	}
+	return e.dict.values[*e.code]
+}
+
+func (e categoricalElement) String() string {
+	if e.IsNA() {
+		return NaN
+	}
+	return e.dict.values[*e.code]
+}
+
+// str returns the element as a String element, the conversions and the comparisons
+// of a Categorical element are the ones of a String element.
+func (e categoricalElement) str() stringElement {
+	if e.IsNA() {
+		return stringElement{"", true}
+	}
+	return stringElement{e.dict.values[*e.code], false}
+}
+
+func (e categoricalElement) Int() (int, error) {
+	return e.str().Int()
+}
+
+func (e categoricalElement) Float() float64 {
+	return e.str().Float()
+}
+
+func (e categoricalElement) Bool() (bool, error) {
+	return e.str().Bool()
+}
+
+func (e categoricalElement) Eq(elem Element) bool {
+	if o, ok := elem.(*categoricalElement); ok && o.dict == e.dict {
+		return !e.IsNA() && !o.IsNA() && *e.code == *o.code
+	}
+	return e.str().Eq(elem)
+}
+
+func (e categoricalElement) Neq(elem Element) bool {
+	if o, ok := elem.(*categoricalElement); ok && o.dict == e.dict {
+		return !e.IsNA() && !o.IsNA() && *e.code != *o.code
+	}
+	return e.str().Neq(elem)
+}
+
+func (e categoricalElement) Less(elem Element) bool {
+	return e.str().Less(elem)
+}
+
+func (e categoricalElement) LessEq(elem Element) bool {
+	return e.str().LessEq(elem)
+}
+
+func (e categoricalElement) Greater(elem Element) bool {
+	return e.str().Greater(elem)
+}
+
+func (e categoricalElement) GreaterEq(elem Element) bool {
+	return e.str().GreaterEq(elem)
+}
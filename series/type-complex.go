@@ -0,0 +1,218 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strconv"
+)
+
+type complexElement struct {
+	e   complex128
+	nan bool
+}
+
+// force complexElement struct to implement Element interface
+var _ Element = (*complexElement)(nil)
+
+func (e *complexElement) Set(value interface{}) {
+	switch val := value.(type) {
+	case string:
+		e.SetString(val)
+	case int:
+		e.SetInt(val)
+	case float64:
+		e.SetFloat(val)
+	case complex128:
+		e.setComplex(val)
+	case complex64:
+		e.setComplex(complex128(val))
+	case bool:
+		e.SetBool(val)
+	case Element:
+		e.SetElement(val)
+	default:
+		e.nan = true
+	}
+}
+
+func (e *complexElement) SetElement(val Element) {
+	if val.IsNA() {
+		e.nan = true
+		return
+	}
+	switch val.Type() {
+	case Complex:
+		if o, ok := val.(*complexElement); ok {
+			e.setComplex(o.e)
+			return
+		}
+		e.setComplex(val.Val().(complex128))
+	case String:
+		e.SetString(val.String())
+	default:
+		e.SetFloat(val.Float())
+	}
+}
+
+func (e *complexElement) SetBool(val bool) {
+	e.nan = false
+	if val {
+		e.e = 1
+	} else {
+		e.e = 0
+	}
+}
+
+func (e *complexElement) SetFloat(val float64) {
+	e.nan = false
+	if math.IsNaN(val) {
+		e.nan = true
+		return
+	}
+	e.e = complex(val, 0)
+}
+
+func (e *complexElement) SetInt(val int) {
+	e.nan = false
+	e.e = complex(float64(val), 0)
+}
+
+// SetString parses val like "(1+2i)", "1+2i" or "3".
+func (e *complexElement) SetString(val string) {
+	e.nan = false
+	if val == NaN {
+		e.nan = true
+		return
+	}
+	c, err := strconv.ParseComplex(val, 128)
+	if err != nil {
+		e.nan = true
+		return
+	}
+	e.setComplex(c)
+}
+
+func (e *complexElement) SetNA() {
+	e.e = 0
+	e.nan = true
+}
+
+func (e *complexElement) setComplex(val complex128) {
+	e.nan = false
+	if cmplx.IsNaN(val) {
+		e.nan = true
+		return
+	}
+	e.e = val
+}
+
+func (e complexElement) Copy() Element {
+	if e.IsNA() {
+		return &complexElement{0, true}
+	}
+	return &complexElement{e.e, false}
+}
+
+func (e complexElement) IsNA() bool {
+	return e.nan
+}
+
+func (e complexElement) Type() Type {
+	return Complex
+}
+
+// Val returns the element as a complex128.
+func (e complexElement) Val() ElementValue {
+	if e.IsNA() {
+		return nil
+	}
+	return e.e
+}
+
+// String returns the element formatted like "(1+2i)".
+func (e complexElement) String() string {
+	if e.IsNA() {
+		return NaN
+	}
+	return strconv.FormatComplex(e.e, 'f', -1, 128)
+}
+
+// Int returns the real part of the element, an error if the imaginary part is not zero.
+func (e complexElement) Int() (int, error) {
+	if e.IsNA() {
+		return 0, fmt.Errorf("can't convert NaN to int")
+	}
+	if imag(e.e) != 0 {
+		return 0, fmt.Errorf("can't convert Complex \"%v\" to int", e.String())
+	}
+	f := real(e.e)
+	if math.IsInf(f, 0) {
+		return 0, fmt.Errorf("can't convert Inf to int")
+	}
+	return int(f), nil
+}
+
+// Float returns the real part of the element, the imaginary part is discarded.
+func (e complexElement) Float() float64 {
+	if e.IsNA() {
+		return math.NaN()
+	}
+	return real(e.e)
+}
+
+func (e complexElement) Bool() (bool, error) {
+	if e.IsNA() {
+		return false, fmt.Errorf("can't convert NaN to bool")
+	}
+	switch e.e {
+	case 1:
+		return true, nil
+	case 0:
+		return false, nil
+	}
+	return false, fmt.Errorf("can't convert Complex \"%v\" to bool", e.String())
+}
+
+// complexOf converts elem to complex128 the same way as SetElement.
+func (e complexElement) complexOf(elem Element) (complex128, bool) {
+	var c complexElement
+	c.SetElement(elem)
+	return c.e, !c.nan && !e.IsNA()
+}
+
+func (e complexElement) Eq(elem Element) bool {
+	c, ok := e.complexOf(elem)
+	if !ok {
+		return false
+	}
+	return e.e == c
+}
+
+func (e complexElement) Neq(elem Element) bool {
+	c, ok := e.complexOf(elem)
+	if !ok {
+		return false
+	}
+	return e.e != c
+}
+
+// Less is always false, complex numbers are not ordered.
+func (e complexElement) Less(elem Element) bool {
+	return false
+}
+
+// LessEq is always false, complex numbers are not ordered.
+func (e complexElement) LessEq(elem Element) bool {
+	return false
+}
+
+// Greater is always false, complex numbers are not ordered.
+func (e complexElement) Greater(elem Element) bool {
+	return false
+}
+
+// GreaterEq is always false, complex numbers are not ordered.
+func (e complexElement) GreaterEq(elem Element) bool {
+	return false
+}
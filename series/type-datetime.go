@@ -0,0 +1,211 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+type dateTimeElement struct {
+	e   int64 // unix nanoseconds
+	nan bool
+}
+
+// force dateTimeElement struct to implement Element interface
+var _ Element = (*dateTimeElement)(nil)
+
+func (e *dateTimeElement) Set(value interface{}) {
+	switch val := value.(type) {
+	case string:
+		e.SetString(val)
+	case int:
+		e.SetInt(val)
+	case float64:
+		e.SetFloat(val)
+	case bool:
+		e.SetBool(val)
+	case time.Time:
+		e.setTime(val)
+	case Element:
+		e.SetElement(val)
+	default:
+		e.nan = true
+	}
+}
+
+func (e *dateTimeElement) SetElement(val Element) {
+	if val.IsNA() {
+		e.nan = true
+		return
+	}
+	switch val.Type() {
+	case DateTime:
+		e.setTime(val.Val().(time.Time))
+	case String:
+		e.SetString(val.String())
+	default:
+		i, err := val.Int()
+		if err != nil {
+			e.nan = true
+			return
+		}
+		e.SetInt(i)
+	}
+}
+
+func (e *dateTimeElement) SetBool(val bool) {
+	e.nan = true
+}
+
+func (e *dateTimeElement) SetFloat(val float64) {
+	e.nan = false
+	if math.IsNaN(val) ||
+		math.IsInf(val, 0) {
+		e.nan = true
+		return
+	}
+	e.e = int64(val)
+}
+
+func (e *dateTimeElement) SetInt(val int) {
+	e.nan = false
+	e.e = int64(val)
+}
+
+// SetString parses val with the RFC3339 layout, fractional seconds are accepted.
+func (e *dateTimeElement) SetString(val string) {
+	e.nan = false
+	if val == NaN {
+		e.nan = true
+		return
+	}
+	t, err := time.Parse(time.RFC3339Nano, val)
+	if err != nil {
+		e.nan = true
+		return
+	}
+	e.e = t.UnixNano()
+}
+
+func (e *dateTimeElement) SetNA() {
+	e.e = 0
+	e.nan = true
+}
+
+func (e *dateTimeElement) setTime(val time.Time) {
+	e.nan = false
+	e.e = val.UnixNano()
+}
+
+func (e dateTimeElement) Copy() Element {
+	if e.IsNA() {
+		return &dateTimeElement{0, true}
+	}
+	return &dateTimeElement{e.e, false}
+}
+
+func (e dateTimeElement) IsNA() bool {
+	return e.nan
+}
+
+func (e dateTimeElement) Type() Type {
+	return DateTime
+}
+
+// Val returns the element as a time.Time in UTC.
+func (e dateTimeElement) Val() ElementValue {
+	if e.IsNA() {
+		return nil
+	}
+	return e.time()
+}
+
+func (e dateTimeElement) time() time.Time {
+	return time.Unix(0, e.e).UTC()
+}
+
+// String returns the element formatted with the RFC3339 layout in UTC.
+func (e dateTimeElement) String() string {
+	if e.IsNA() {
+		return NaN
+	}
+	return e.time().Format(time.RFC3339Nano)
+}
+
+// Int returns the element as unix nanoseconds.
+func (e dateTimeElement) Int() (int, error) {
+	if e.IsNA() {
+		return 0, fmt.Errorf("can't convert NaN to int")
+	}
+	return int(e.e), nil
+}
+
+// Float returns the element as unix nanoseconds.
+func (e dateTimeElement) Float() float64 {
+	if e.IsNA() {
+		return math.NaN()
+	}
+	return float64(e.e)
+}
+
+func (e dateTimeElement) Bool() (bool, error) {
+	if e.IsNA() {
+		return false, fmt.Errorf("can't convert NaN to bool")
+	}
+	return false, fmt.Errorf("can't convert DateTime \"%v\" to bool", e.String())
+}
+
+// unixNano converts elem to unix nanoseconds the same way as SetElement.
+func (e dateTimeElement) unixNano(elem Element) (int64, bool) {
+	var d dateTimeElement
+	d.SetElement(elem)
+	return d.e, !d.nan && !e.IsNA()
+}
+
+func (e dateTimeElement) Eq(elem Element) bool {
+	t, ok := e.unixNano(elem)
+	if !ok {
+		return false
+	}
+	return e.e == t
+}
+
+func (e dateTimeElement) Neq(elem Element) bool {
+	t, ok := e.unixNano(elem)
+	if !ok {
+		return false
+	}
+	return e.e != t
+}
+
+func (e dateTimeElement) Less(elem Element) bool {
+	t, ok := e.unixNano(elem)
+	if !ok {
+		return false
+	}
+	return e.e < t
+}
+
+func (e dateTimeElement) LessEq(elem Element) bool {
+	t, ok := e.unixNano(elem)
+	if !ok {
+		return false
+	}
+	return e.e <= t
+}
+
+func (e dateTimeElement) Greater(elem Element) bool {
+	t, ok := e.unixNano(elem)
+	if !ok {
+		return false
+	}
+	return e.e > t
+}
+
+func (e dateTimeElement) GreaterEq(elem Element) bool {
+	t, ok := e.unixNano(elem)
+	if !ok {
+		return false
+	}
+	return e.e >= t
+}
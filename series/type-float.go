@@ -72,6 +72,11 @@ func (e *floatElement) SetString(val string) {
 	e.e = f
 }
 
+func (e *floatElement) SetNA() {
+	e.e = 0
+	e.nan = true
+}
+
 func (e floatElement) Copy() Element {
 	if e.IsNA() {
 		return &floatElement{0.0, true}
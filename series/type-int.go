@@ -76,6 +76,11 @@ func (e *intElement) SetString(val string) {
 	e.e = i
 }
 
+func (e *intElement) SetNA() {
+	e.e = 0
+	e.nan = true
+}
+
 func (e intElement) Copy() Element {
 	if e.IsNA() {
 		return &intElement{0, true}
@@ -0,0 +1,226 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+type int64Element struct {
+	e   int64
+	nan bool
+}
+
+// force int64Element struct to implement Element interface
+var _ Element = (*int64Element)(nil)
+
+func (e *int64Element) Set(value interface{}) {
+	switch val := value.(type) {
+	case string:
+		e.SetString(val)
+	case int:
+		e.SetInt(val)
+	case int64:
+		e.setInt64(val)
+	case float64:
+		e.SetFloat(val)
+	case bool:
+		e.SetBool(val)
+	case Element:
+		e.SetElement(val)
+	default:
+		e.nan = true
+	}
+}
+
+// SetElement converts val without a float round-trip unless val is a Float element.
+func (e *int64Element) SetElement(val Element) {
+	if val.IsNA() {
+		e.nan = true
+		return
+	}
+	switch val.Type() {
+	case Int64:
+		if o, ok := val.(*int64Element); ok {
+			e.setInt64(o.e)
+			return
+		}
+		e.setInt64(val.Val().(int64))
+	case String:
+		e.SetString(val.String())
+	case Float:
+		e.SetFloat(val.Float())
+	default:
+		i, err := val.Int()
+		if err != nil {
+			e.nan = true
+			return
+		}
+		e.SetInt(i)
+	}
+}
+
+func (e *int64Element) SetBool(val bool) {
+	e.nan = false
+	if val {
+		e.e = 1
+	} else {
+		e.e = 0
+	}
+}
+
+func (e *int64Element) SetFloat(val float64) {
+	e.nan = false
+	if math.IsNaN(val) ||
+		math.IsInf(val, 0) {
+		e.nan = true
+		return
+	}
+	e.e = int64(val)
+}
+
+func (e *int64Element) SetInt(val int) {
+	e.nan = false
+	e.e = int64(val)
+}
+
+func (e *int64Element) SetString(val string) {
+	e.nan = false
+	if val == NaN {
+		e.nan = true
+		return
+	}
+	i, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		e.nan = true
+		return
+	}
+	e.e = i
+}
+
+func (e *int64Element) SetNA() {
+	e.e = 0
+	e.nan = true
+}
+
+func (e *int64Element) setInt64(val int64) {
+	e.nan = false
+	e.e = val
+}
+
+func (e int64Element) Copy() Element {
+	if e.IsNA() {
+		return &int64Element{0, true}
+	}
+	return &int64Element{e.e, false}
+}
+
+func (e int64Element) IsNA() bool {
+	return e.nan
+}
+
+func (e int64Element) Type() Type {
+	return Int64
+}
+
+// Val returns the element as an int64.
+func (e int64Element) Val() ElementValue {
+	if e.IsNA() {
+		return nil
+	}
+	return e.e
+}
+
+func (e int64Element) String() string {
+	if e.IsNA() {
+		return NaN
+	}
+	return strconv.FormatInt(e.e, 10)
+}
+
+// Int returns an error if the element overflows int on the platform.
+func (e int64Element) Int() (int, error) {
+	if e.IsNA() {
+		return 0, fmt.Errorf("can't convert NaN to int")
+	}
+	if int64(int(e.e)) != e.e {
+		return 0, fmt.Errorf("can't convert Int64 \"%v\" to int: overflow", e.e)
+	}
+	return int(e.e), nil
+}
+
+// Float returns the element as a float64, the values beyond 2^53 lose precision.
+func (e int64Element) Float() float64 {
+	if e.IsNA() {
+		return math.NaN()
+	}
+	return float64(e.e)
+}
+
+func (e int64Element) Bool() (bool, error) {
+	if e.IsNA() {
+		return false, fmt.Errorf("can't convert NaN to bool")
+	}
+	switch e.e {
+	case 1:
+		return true, nil
+	case 0:
+		return false, nil
+	}
+	return false, fmt.Errorf("can't convert Int64 \"%v\" to bool", e.e)
+}
+
+// int64Of converts elem to int64 the same way as SetElement.
+func (e int64Element) int64Of(elem Element) (int64, bool) {
+	var i int64Element
+	i.SetElement(elem)
+	return i.e, !i.nan && !e.IsNA()
+}
+
+func (e int64Element) Eq(elem Element) bool {
+	i, ok := e.int64Of(elem)
+	if !ok {
+		return false
+	}
+	return e.e == i
+}
+
+func (e int64Element) Neq(elem Element) bool {
+	i, ok := e.int64Of(elem)
+	if !ok {
+		return false
+	}
+	return e.e != i
+}
+
+func (e int64Element) Less(elem Element) bool {
+	i, ok := e.int64Of(elem)
+	if !ok {
+		return false
+	}
+	return e.e < i
+}
+
+func (e int64Element) LessEq(elem Element) bool {
+	i, ok := e.int64Of(elem)
+	if !ok {
+		return false
+	}
+	return e.e <= i
+}
+
+func (e int64Element) Greater(elem Element) bool {
+	i, ok := e.int64Of(elem)
+	if !ok {
+		return false
+	}
+	return e.e > i
+}
+
+func (e int64Element) GreaterEq(elem Element) bool {
+	i, ok := e.int64Of(elem)
+	if !ok {
+		return false
+	}
+	return e.e >= i
+}
@@ -68,6 +68,11 @@ func (e *stringElement) SetString(val string) {
 	}
 }
 
+func (e *stringElement) SetNA() {
+	e.e = ""
+	e.nan = true
+}
+
 func (e stringElement) Copy() Element {
 	if e.IsNA() {
 		return &stringElement{"", true}
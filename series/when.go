@@ -1,5 +1,9 @@
 package series
 
+import (
+	"fmt"
+)
+
 type WhenFilterFunction func(ele Element, index int) bool
 type WhenApplyFunction func(newEle Element, index int)
 
@@ -7,6 +11,27 @@ type WhenApplyFunction func(newEle Element, index int)
 type When interface {
 	//We do the operation on the elements that satisfy the condition and do nothing on the elements that dose not satisfy the condition.
 	Apply(f WhenApplyFunction) Series
+	// Then sets the value of the elements that satisfy the condition.
+	// The value is converted to the type of the Series, nil is NaN.
+	Then(value interface{}) Then
+}
+
+// Then is a When with its value, it can be chained like a case expression:
+//
+//	s.When(isNegative).Then(-1).When(isZero).Then(0).Otherwise(1)
+//
+// Each element gets the value of the first condition it satisfies.
+type Then interface {
+	// When adds another condition, which is checked when the previous ones are not satisfied.
+	When(whenF WhenFilterFunction) When
+	// Otherwise returns a new Series where the elements get the value of the first condition
+	// they satisfy, and value when they satisfy none. The value is converted like Then.
+	Otherwise(value interface{}) Series
+}
+
+type whenCase struct {
+	whenF WhenFilterFunction
+	value interface{}
 }
 
 func newWhen(whenF WhenFilterFunction, s Series) When {
@@ -20,6 +45,45 @@ func newWhen(whenF WhenFilterFunction, s Series) When {
 type when struct {
 	whenF WhenFilterFunction
 	s     Series
+	// the cases of the previous When...Then
+	cases []whenCase
+}
+
+func (e when) Then(value interface{}) Then {
+	cases := make([]whenCase, len(e.cases), len(e.cases)+1)
+	copy(cases, e.cases)
+	return then{
+		s:     e.s,
+		cases: append(cases, whenCase{e.whenF, value}),
+	}
+}
+
+type then struct {
+	s     Series
+	cases []whenCase
+}
+
+func (e then) When(whenF WhenFilterFunction) When {
+	return when{
+		whenF: whenF,
+		s:     e.s,
+		cases: e.cases,
+	}
+}
+
+func (e then) Otherwise(value interface{}) Series {
+	ret := e.s.Map(func(ele Element, index int) Element {
+		newEle := ele.Copy()
+		for _, c := range e.cases {
+			if c.whenF(ele, index) {
+				newEle.Set(c.value)
+				return newEle
+			}
+		}
+		newEle.Set(value)
+		return newEle
+	})
+	return ret
 }
 
 func (e when) Apply(f WhenApplyFunction) Series {
@@ -32,3 +96,52 @@ func (e when) Apply(f WhenApplyFunction) Series {
 	})
 	return ret
 }
+
+// Where keeps the elements where cond is true and replaces the others by other,
+// returning a new Series object. cond must be a Bool Series of the same length, NaN in cond is false.
+func (s series) Where(cond Series, other ElementValue) Series {
+	ret := s.replaceWhen(cond, other, false)
+	ret.SetName(fmt.Sprintf("%s_Where(%s)", s.name, cond.Name()))
+	return ret
+}
+
+// Mask replaces the elements where cond is true by other and keeps the others,
+// returning a new Series object. cond must be a Bool Series of the same length, NaN in cond is false.
+func (s series) Mask(cond Series, other ElementValue) Series {
+	ret := s.replaceWhen(cond, other, true)
+	ret.SetName(fmt.Sprintf("%s_Mask(%s)", s.name, cond.Name()))
+	return ret
+}
+
+// replaceWhen replaces the elements by other where the condition equals to replaceOn.
+func (s series) replaceWhen(cond Series, other ElementValue, replaceOn bool) Series {
+	if err := s.err; err != nil {
+		return &s
+	}
+	if err := cond.Error(); err != nil {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("condition has errors: %v", err))
+		return empty
+	}
+	if cond.Type() != Bool {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("condition must be of type Bool"))
+		return empty
+	}
+	if cond.Len() != s.Len() {
+		empty := s.Empty()
+		empty.SetErr(fmt.Errorf("condition length mismatch"))
+		return empty
+	}
+	return s.Map(func(ele Element, index int) Element {
+		ret := ele.Copy()
+		b, err := cond.Elem(index).Bool()
+		if err != nil {
+			b = false
+		}
+		if b == replaceOn {
+			ret.Set(other)
+		}
+		return ret
+	})
+}
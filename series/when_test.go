@@ -1,6 +1,7 @@
 package series
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -51,3 +52,114 @@ func TestSeries_When(t *testing.T) {
 	}
 
 }
+
+func TestSeries_WhenThenOtherwise(t *testing.T) {
+	isNegative := func(ele Element, index int) bool {
+		return ele.Float() < 0
+	}
+	isZero := func(ele Element, index int) bool {
+		return ele.Float() == 0
+	}
+	tests := []struct {
+		series   Series
+		build    func(s Series) Series
+		expected Series
+	}{
+		{
+			Floats([]string{"1.5", "-3", NaN, "0"}),
+			func(s Series) Series {
+				return s.When(isNegative).Then(0).Otherwise(1)
+			},
+			Floats([]string{"1", "0", "1", "1"}),
+		},
+		{
+			Ints([]string{"5", "-3", NaN, "0", "-1"}),
+			func(s Series) Series {
+				return s.When(isNegative).Then(-1).When(isZero).Then("0").Otherwise(nil)
+			},
+			Ints([]string{NaN, "-1", NaN, "0", "-1"}),
+		},
+		{
+			Strings([]string{"a", "b", "c"}),
+			func(s Series) Series {
+				return s.When(func(ele Element, index int) bool {
+					return index > 0
+				}).Then("late").When(func(ele Element, index int) bool {
+					return index > 1
+				}).Then("never").Otherwise("first")
+			},
+			Strings([]string{"first", "late", "late"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		original := test.series.Records()
+		received := test.build(test.series)
+		if !reflect.DeepEqual(test.expected.Records(), received.Records()) || received.Type() != test.series.Type() {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+		if !reflect.DeepEqual(original, test.series.Records()) {
+			t.Errorf("Test:%v\nExpected the series to be unchanged, received %v", testnum, test.series)
+		}
+	}
+
+	// a When...Then can be reused for different branches
+	s := Ints([]int{-1, 1})
+	negative := s.When(isNegative).Then(0)
+	a := negative.When(isZero).Then(5).Otherwise(1)
+	b := negative.Otherwise(2)
+	if !reflect.DeepEqual(a.Records(), []string{"0", "1"}) || !reflect.DeepEqual(b.Records(), []string{"0", "2"}) {
+		t.Errorf("Expected independent branches, received %v and %v", a, b)
+	}
+}
+
+func TestSeries_WhereMask(t *testing.T) {
+	tests := []struct {
+		series        Series
+		cond          Series
+		other         ElementValue
+		whereExpected Series
+		maskExpected  Series
+	}{
+		{
+			Floats([]float64{1.5, -3.23, -0.33, 1.6}),
+			Bools([]string{"true", "false", NaN, "true"}),
+			0,
+			Floats([]float64{1.5, 0, 0, 1.6}),
+			Floats([]float64{0, -3.23, -0.33, 0}),
+		},
+		{
+			Ints([]int{1, 2, 3}),
+			Ints([]int{1, 2, 3}).Compare(Greater, 1),
+			nil,
+			Ints([]string{NaN, "2", "3"}),
+			Ints([]string{"1", NaN, NaN}),
+		},
+	}
+
+	for testnum, test := range tests {
+		expected := test.whereExpected.Records()
+		received := test.series.Where(test.cond, test.other).Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-Where:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+		expected = test.maskExpected.Records()
+		received = test.series.Mask(test.cond, test.other).Records()
+		if !reflect.DeepEqual(expected, received) {
+			t.Errorf(
+				"Test-Mask:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, expected, received,
+			)
+		}
+	}
+
+	if err := Ints([]int{1, 2}).Where(Bools([]bool{true}), 0).Error(); err == nil {
+		t.Errorf("Expected error on condition length mismatch")
+	}
+}
@@ -1,9 +1,16 @@
 package series
 
+import "time"
+
 //Wrapper define special operations for multiple Series
 type Wrapper interface {
 	FloatApply(f func(thisValue float64, wrapValues []float64) float64) Series
 	BoolApply(f func(thisValue bool, wrapValues []bool) bool) Series
+	// Map combines the series elementwise, f is called with the elements of this series followed
+	// by the elements of the wrapped series at each index. The type of the result is inferred from
+	// the first non-nil returned value, int, int64, float64, complex128, bool, string, time.Time or
+	// an Element, and is the type of this series when all the values are nil.
+	Map(f func(eles ...Element) interface{}) Series
 }
 
 //wrapper implements Wrapper
@@ -63,6 +70,53 @@ func (w wrapper) BoolApply(f func(thisValue bool, wrapValues []bool) bool) Serie
 	return ret
 }
 
+func (w wrapper) Map(f func(eles ...Element) interface{}) Series {
+	seriess := append([]Series{w.thisSeries}, w.ss...)
+	values := make([]interface{}, w.thisSeries.Len())
+	t := w.thisSeries.Type()
+	inferred := false
+	for i := range values {
+		eles := make([]Element, len(seriess))
+		for j, s := range seriess {
+			eles[j] = s.Elem(i)
+		}
+		values[i] = f(eles...)
+		if !inferred && values[i] != nil {
+			t, inferred = valueType(values[i], t), true
+		}
+	}
+	ret, err := OperationTyped(t, func(index int, eles ...Element) interface{} {
+		return values[index]
+	}, seriess...)
+	if err != nil {
+		return Err(err)
+	}
+	return ret
+}
+
+// valueType returns the Type of the series holding value, or def if value has no matching Type.
+func valueType(value interface{}, def Type) Type {
+	switch v := value.(type) {
+	case int:
+		return Int
+	case int64:
+		return Int64
+	case float64:
+		return Float
+	case complex128:
+		return Complex
+	case bool:
+		return Bool
+	case string:
+		return String
+	case time.Time:
+		return DateTime
+	case Element:
+		return v.Type()
+	}
+	return def
+}
+
 func rowBools(index int, ss []Series) ([]bool, error) {
 	length := len(ss)
 	if length == 0 {
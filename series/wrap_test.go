@@ -91,6 +91,77 @@ func TestSeries_Wrap_BoolApply(t *testing.T) {
 
 }
 
+func TestSeries_Wrap_Map(t *testing.T) {
+	tests := []struct {
+		series   Series
+		ss       []Series
+		f        func(eles ...Element) interface{}
+		expected Series
+	}{
+		{
+			Floats([]float64{1, 2, 3}),
+			[]Series{Ints([]int{10, 20, 30}), Floats([]float64{0.5, 0.5, 2})},
+			func(eles ...Element) interface{} {
+				return (eles[0].Float() + eles[1].Float()) * eles[2].Float()
+			},
+			Floats([]float64{5.5, 11, 66}),
+		},
+		{
+			Strings([]string{"a", "b"}),
+			[]Series{Ints([]string{"1", NaN}), Bools([]bool{true, false})},
+			func(eles ...Element) interface{} {
+				if eles[1].IsNA() {
+					return nil
+				}
+				return fmt.Sprintf("%s%s%s", eles[0], eles[1], eles[2])
+			},
+			Strings([]string{"a1true", NaN}),
+		},
+		{
+			Ints([]int{1, 2}),
+			nil,
+			func(eles ...Element) interface{} {
+				v, _ := eles[0].Int()
+				return v * v
+			},
+			Ints([]int{1, 4}),
+		},
+		{
+			Ints([]string{"1", NaN, "2"}),
+			[]Series{Floats([]float64{0.5, 0.5, 0.5})},
+			func(eles ...Element) interface{} {
+				if eles[0].IsNA() {
+					return nil
+				}
+				return eles[0].Float() + eles[1].Float()
+			},
+			Floats([]string{"1.5", NaN, "2.5"}),
+		},
+	}
+
+	for testnum, test := range tests {
+		received := test.series.Wrap(test.ss...).Map(test.f)
+		if err := received.Error(); err != nil {
+			t.Errorf("Test:%v\nError:%v", testnum, err)
+		}
+		if !reflect.DeepEqual(test.expected.Records(), received.Records()) || received.Type() != test.expected.Type() {
+			t.Errorf(
+				"Test:%v\nExpected:\n%v\nReceived:\n%v",
+				testnum, test.expected, received,
+			)
+		}
+	}
+
+	defer func() {
+		if err := recover(); err == nil {
+			t.Errorf("Expected panic on length mismatch")
+		}
+	}()
+	Ints([]int{1, 2}).Wrap(Ints([]int{1})).Map(func(eles ...Element) interface{} {
+		return nil
+	})
+}
+
 func formatFloat(f float64, format string) float64 {
 	f1 := fmt.Sprintf(format, f)
 	f2, _ := strconv.ParseFloat(f1, 64)